@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
@@ -39,7 +40,8 @@ func NewAdviser(svc app.AdviserApp, logger log.Logger, duration metrics.Histogra
 
 func MakeGetAdvicesEndpoint(s app.AdviserApp) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		advices, err := s.GetAdvices(ctx)
+		req := request.(GetAdvicesRequest)
+		advices, err := s.GetAdvices(ctx, req.Filter, req.At)
 		return GetAdvicesResponse{Advices: advices, Err: err}, nil
 	}
 }
@@ -48,7 +50,10 @@ var (
 	_ endpoint.Failer = GetAdvicesResponse{}
 )
 
-type GetAdvicesRequest struct{}
+type GetAdvicesRequest struct {
+	Filter app.AdviceFilter
+	At     time.Time
+}
 
 type GetAdvicesResponse struct {
 	Advices []advice.Advice
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/tracing/opentracing"
@@ -13,6 +14,8 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/websmee/example_of_my_code/adviser/api/proto"
+	"github.com/websmee/example_of_my_code/adviser/app"
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
 )
 
@@ -48,8 +51,35 @@ func (s *grpcServer) GetAdvices(ctx context.Context, req *proto.GetAdvicesReques
 	return rep.(*proto.GetAdvicesReply), nil
 }
 
-func decodeGRPCGetAdvicesRequest(_ context.Context, _ interface{}) (interface{}, error) {
-	return GetAdvicesRequest{}, nil
+// decodeGRPCGetAdvicesRequest returns the advices of every status unless ok_only is set, as v1 did before the filter
+func decodeGRPCGetAdvicesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetAdvicesRequest)
+	return decodeGetAdvicesRequest(req, !req.OkOnly)
+}
+
+func decodeGetAdvicesRequest(req *proto.GetAdvicesRequest, diagnostics bool) (interface{}, error) {
+	var at time.Time
+	if req.At != "" {
+		var err error
+		at, err = time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return GetAdvicesRequest{
+		Filter: decodeAdviceFilter(req.Symbols, req.AdviserTypes, diagnostics),
+		At:     at,
+	}, nil
+}
+
+func decodeAdviceFilter(symbols, adviserTypes []string, diagnostics bool) app.AdviceFilter {
+	filter := app.AdviceFilter{Symbols: symbols, Diagnostics: diagnostics}
+	for _, t := range adviserTypes {
+		filter.AdviserTypes = append(filter.AdviserTypes, advice.AdviserType(t))
+	}
+
+	return filter
 }
 
 func encodeGRPCGetAdvicesResponse(_ context.Context, response interface{}) (interface{}, error) {
@@ -63,13 +93,16 @@ func encodeGRPCGetAdvicesResponse(_ context.Context, response interface{}) (inte
 			},
 			Candlesticks:     encodeCandlesticks(resp.Advices[i].Candlesticks),
 			Price:            decimalToFloat32(resp.Advices[i].Price),
-			Amount:           decimalToFloat32(resp.Advices[i].Price),
-			TakeProfitPrice:  decimalToFloat32(resp.Advices[i].Price),
-			TakeProfitAmount: decimalToFloat32(resp.Advices[i].Price),
-			StopLossPrice:    decimalToFloat32(resp.Advices[i].Price),
-			StopLossAmount:   decimalToFloat32(resp.Advices[i].Price),
+			Amount:           decimalToFloat32(resp.Advices[i].Amount),
+			TakeProfitPrice:  decimalToFloat32(resp.Advices[i].TakeProfitPrice),
+			TakeProfitAmount: decimalToFloat32(resp.Advices[i].TakeProfitAmount),
+			StopLossPrice:    decimalToFloat32(resp.Advices[i].StopLossPrice),
+			StopLossAmount:   decimalToFloat32(resp.Advices[i].StopLossAmount),
 			Leverage:         int64(resp.Advices[i].Leverage),
 			ExpiresAt:        resp.Advices[i].ExpiresAt.Unix(),
+			AdviserType:      string(resp.Advices[i].AdviserType),
+			Status:           string(resp.Advices[i].Status),
+			ParamsVersion:    int64(resp.Advices[i].ParamsVersion),
 		}
 	}
 
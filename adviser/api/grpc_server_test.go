@@ -0,0 +1,30 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/websmee/example_of_my_code/adviser/api/proto"
+)
+
+func TestDecodeGRPCGetAdvicesRequest_Statuses(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		decode   func(context.Context, interface{}) (interface{}, error)
+		req      *proto.GetAdvicesRequest
+		expected bool
+	}{
+		{"v1 empty", decodeGRPCGetAdvicesRequest, &proto.GetAdvicesRequest{}, true},
+		{"v1 ok only", decodeGRPCGetAdvicesRequest, &proto.GetAdvicesRequest{OkOnly: true}, false},
+		{"v2 empty", decodeGRPCGetAdvicesV2Request, &proto.GetAdvicesRequest{}, false},
+		{"v2 diagnostics", decodeGRPCGetAdvicesV2Request, &proto.GetAdvicesRequest{IncludeDiagnostics: true}, true},
+	} {
+		req, err := c.decode(context.Background(), c.req)
+		if err != nil {
+			t.Fatal(c.name, err)
+		}
+		if diagnostics := req.(GetAdvicesRequest).Filter.Diagnostics; diagnostics != c.expected {
+			t.Errorf("%s: expected diagnostics %t, got %t", c.name, c.expected, diagnostics)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/adviser/api/proto"
+	"github.com/websmee/example_of_my_code/adviser/app"
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
+)
+
+type grpcServerV2 struct {
+	proto.UnimplementedAdviserV2Server
+	getAdvices grpctransport.Handler
+	svc        app.AdviserApp
+}
+
+// NewGRPCServerV2 serves the same endpoints as NewGRPCServer, but keeps prices and amounts as decimal strings.
+// The advice subscriptions are served by the app directly.
+func NewGRPCServerV2(endpoints Adviser, svc app.AdviserApp, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) proto.AdviserV2Server {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer))
+	}
+
+	return &grpcServerV2{
+		getAdvices: grpctransport.NewServer(
+			endpoints.GetAdvicesEndpoint,
+			decodeGRPCGetAdvicesV2Request,
+			encodeGRPCGetAdvicesV2Response,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetAdvices", logger)))...,
+		),
+		svc: svc,
+	}
+}
+
+func (s *grpcServerV2) GetAdvices(ctx context.Context, req *proto.GetAdvicesRequest) (*proto.GetAdvicesV2Reply, error) {
+	_, rep, err := s.getAdvices.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetAdvicesV2Reply), nil
+}
+
+// decodeGRPCGetAdvicesV2Request returns the advices of "ok" only unless include_diagnostics is set
+func decodeGRPCGetAdvicesV2Request(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetAdvicesRequest)
+	return decodeGetAdvicesRequest(req, req.IncludeDiagnostics)
+}
+
+// SubscribeAdvices isn't supported by go-kit transport, it sends the advices until the client leaves.
+func (s *grpcServerV2) SubscribeAdvices(req *proto.SubscribeAdvicesRequest, stream proto.AdviserV2_SubscribeAdvicesServer) error {
+	filter := decodeAdviceFilter(req.Symbols, req.AdviserTypes, req.IncludeDiagnostics)
+	advices, cancel, err := s.svc.SubscribeAdvices(filter, req.Cursor)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case a, ok := <-advices:
+			if !ok {
+				return errors.New("subscriber is too far behind, resume by the last cursor")
+			}
+			if err := stream.Send(&proto.AdviceEvent{Cursor: a.Cursor, Advice: encodeAdviceV2(a.Advice)}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func encodeGRPCGetAdvicesV2Response(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetAdvicesResponse)
+	advices := make([]*proto.AdviceV2, len(resp.Advices))
+	for i := range resp.Advices {
+		advices[i] = encodeAdviceV2(resp.Advices[i])
+	}
+
+	return &proto.GetAdvicesV2Reply{Advices: advices, Err: err2str(resp.Err)}, nil
+}
+
+func encodeAdviceV2(a advice.Advice) *proto.AdviceV2 {
+	return &proto.AdviceV2{
+		Quote: &proto.AdviceQuote{
+			Symbol: a.Quote.Symbol,
+			Name:   a.Quote.Name,
+		},
+		Candlesticks:     encodeCandlesticksV2(a.Candlesticks),
+		Price:            a.Price.String(),
+		Amount:           a.Amount.String(),
+		TakeProfitPrice:  a.TakeProfitPrice.String(),
+		TakeProfitAmount: a.TakeProfitAmount.String(),
+		StopLossPrice:    a.StopLossPrice.String(),
+		StopLossAmount:   a.StopLossAmount.String(),
+		Leverage:         int64(a.Leverage),
+		ExpiresAt:        a.ExpiresAt.Unix(),
+		AdviserType:      string(a.AdviserType),
+		Status:           string(a.Status),
+		ParamsVersion:    int64(a.ParamsVersion),
+	}
+}
+
+func encodeCandlesticksV2(candlesticks []candlestick.Candlestick) []*proto.AdviceCandlestickV2 {
+	cs := make([]*proto.AdviceCandlestickV2, len(candlesticks))
+	for i := range candlesticks {
+		cs[i] = &proto.AdviceCandlestickV2{
+			Open:      candlesticks[i].Open.String(),
+			Low:       candlesticks[i].Low.String(),
+			High:      candlesticks[i].High.String(),
+			Close:     candlesticks[i].Close.String(),
+			AdjClose:  candlesticks[i].AdjClose.String(),
+			Volume:    int64(candlesticks[i].Volume),
+			Timestamp: candlesticks[i].Timestamp.Unix(),
+			Interval:  string(candlesticks[i].Interval),
+		}
+	}
+
+	return cs
+}
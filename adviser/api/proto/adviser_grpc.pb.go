@@ -99,3 +99,161 @@ var Adviser_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/adviser.proto",
 }
+
+// AdviserV2Client is the client API for AdviserV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdviserV2Client interface {
+	GetAdvices(ctx context.Context, in *GetAdvicesRequest, opts ...grpc.CallOption) (*GetAdvicesV2Reply, error)
+	// SubscribeAdvices sends the advices as they are produced. A client resuming after a break passes the cursor
+	// of the last advice it got, the stream fails if the advices after it aren't kept anymore.
+	// The advices are kept in memory, so a restart of the service expires every cursor,
+	// the client catches up by GetAdvices and subscribes without one.
+	SubscribeAdvices(ctx context.Context, in *SubscribeAdvicesRequest, opts ...grpc.CallOption) (AdviserV2_SubscribeAdvicesClient, error)
+}
+
+type adviserV2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdviserV2Client(cc grpc.ClientConnInterface) AdviserV2Client {
+	return &adviserV2Client{cc}
+}
+
+func (c *adviserV2Client) GetAdvices(ctx context.Context, in *GetAdvicesRequest, opts ...grpc.CallOption) (*GetAdvicesV2Reply, error) {
+	out := new(GetAdvicesV2Reply)
+	err := c.cc.Invoke(ctx, "/proto.AdviserV2/GetAdvices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adviserV2Client) SubscribeAdvices(ctx context.Context, in *SubscribeAdvicesRequest, opts ...grpc.CallOption) (AdviserV2_SubscribeAdvicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdviserV2_ServiceDesc.Streams[0], "/proto.AdviserV2/SubscribeAdvices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adviserV2SubscribeAdvicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdviserV2_SubscribeAdvicesClient interface {
+	Recv() (*AdviceEvent, error)
+	grpc.ClientStream
+}
+
+type adviserV2SubscribeAdvicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adviserV2SubscribeAdvicesClient) Recv() (*AdviceEvent, error) {
+	m := new(AdviceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdviserV2Server is the server API for AdviserV2 service.
+// All implementations must embed UnimplementedAdviserV2Server
+// for forward compatibility
+type AdviserV2Server interface {
+	GetAdvices(context.Context, *GetAdvicesRequest) (*GetAdvicesV2Reply, error)
+	// SubscribeAdvices sends the advices as they are produced. A client resuming after a break passes the cursor
+	// of the last advice it got, the stream fails if the advices after it aren't kept anymore.
+	// The advices are kept in memory, so a restart of the service expires every cursor,
+	// the client catches up by GetAdvices and subscribes without one.
+	SubscribeAdvices(*SubscribeAdvicesRequest, AdviserV2_SubscribeAdvicesServer) error
+	mustEmbedUnimplementedAdviserV2Server()
+}
+
+// UnimplementedAdviserV2Server must be embedded to have forward compatible implementations.
+type UnimplementedAdviserV2Server struct {
+}
+
+func (UnimplementedAdviserV2Server) GetAdvices(context.Context, *GetAdvicesRequest) (*GetAdvicesV2Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdvices not implemented")
+}
+func (UnimplementedAdviserV2Server) SubscribeAdvices(*SubscribeAdvicesRequest, AdviserV2_SubscribeAdvicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAdvices not implemented")
+}
+func (UnimplementedAdviserV2Server) mustEmbedUnimplementedAdviserV2Server() {}
+
+// UnsafeAdviserV2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdviserV2Server will
+// result in compilation errors.
+type UnsafeAdviserV2Server interface {
+	mustEmbedUnimplementedAdviserV2Server()
+}
+
+func RegisterAdviserV2Server(s grpc.ServiceRegistrar, srv AdviserV2Server) {
+	s.RegisterService(&AdviserV2_ServiceDesc, srv)
+}
+
+func _AdviserV2_GetAdvices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdviserV2Server).GetAdvices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AdviserV2/GetAdvices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdviserV2Server).GetAdvices(ctx, req.(*GetAdvicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdviserV2_SubscribeAdvices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAdvicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdviserV2Server).SubscribeAdvices(m, &adviserV2SubscribeAdvicesServer{stream})
+}
+
+type AdviserV2_SubscribeAdvicesServer interface {
+	Send(*AdviceEvent) error
+	grpc.ServerStream
+}
+
+type adviserV2SubscribeAdvicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adviserV2SubscribeAdvicesServer) Send(m *AdviceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AdviserV2_ServiceDesc is the grpc.ServiceDesc for AdviserV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdviserV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.AdviserV2",
+	HandlerType: (*AdviserV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAdvices",
+			Handler:    _AdviserV2_GetAdvices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAdvices",
+			Handler:       _AdviserV2_SubscribeAdvices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/adviser.proto",
+}
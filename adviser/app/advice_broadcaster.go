@@ -0,0 +1,203 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+)
+
+// PublishedAdvice carries the cursor a subscriber resumes after.
+type PublishedAdvice struct {
+	Cursor string
+	Advice advice.Advice
+}
+
+// AdviceFilter selects advices by the fields that are set.
+type AdviceFilter struct {
+	Symbols      []string
+	AdviserTypes []advice.AdviserType
+	// Diagnostics adds the advices of the statuses other than StatusOK, telling why there is no trade
+	Diagnostics bool
+}
+
+// ErrCursorExpired is returned for a cursor of the advices not kept anymore or given by another run of the service.
+var ErrCursorExpired = errors.New("cursor expired, get the current advices and subscribe without a cursor")
+
+// AdviceBroadcaster passes the produced advices to the subscribers of this instance.
+// It keeps the last advices in memory only, the cursors don't survive a restart.
+type AdviceBroadcaster interface {
+	Publish(advices []advice.Advice)
+	// Subscribe sends the kept advices published after the cursor first, an empty cursor starts with the next advice.
+	// The channel is closed when the subscription is cancelled or the subscriber falls too far behind.
+	Subscribe(filter AdviceFilter, cursor string) (advices <-chan PublishedAdvice, cancel func(), err error)
+}
+
+const (
+	// keptAdvices is how many last advices a subscriber can resume from
+	keptAdvices = 10000
+	// adviceSubscriptionBuffer is how many advices a subscriber may fall behind
+	adviceSubscriptionBuffer = 1000
+)
+
+type adviceSubscription struct {
+	filter  adviceMatcher
+	advices chan PublishedAdvice
+}
+
+type adviceBroadcaster struct {
+	mu sync.Mutex
+	// epoch tells the cursors of this run from the ones of the previous runs
+	epoch         int64
+	seq           uint64
+	kept          []PublishedAdvice
+	subscriptions map[*adviceSubscription]bool
+}
+
+func NewAdviceBroadcaster() AdviceBroadcaster {
+	return &adviceBroadcaster{
+		epoch:         time.Now().UnixNano(),
+		subscriptions: make(map[*adviceSubscription]bool),
+	}
+}
+
+func (r *adviceBroadcaster) Publish(advices []advice.Advice) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range advices {
+		r.seq++
+		published := PublishedAdvice{Cursor: r.cursor(r.seq), Advice: advices[i]}
+
+		r.kept = append(r.kept, published)
+		if len(r.kept) > keptAdvices {
+			r.kept = r.kept[len(r.kept)-keptAdvices:]
+		}
+
+		for s := range r.subscriptions {
+			if !s.filter.match(published.Advice) {
+				continue
+			}
+
+			// a subscriber this far behind has to resume by its last cursor
+			select {
+			case s.advices <- published:
+			default:
+				delete(r.subscriptions, s)
+				close(s.advices)
+			}
+		}
+	}
+}
+
+func (r *adviceBroadcaster) Subscribe(filter AdviceFilter, cursor string) (<-chan PublishedAdvice, func(), error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var replay []PublishedAdvice
+	if cursor != "" {
+		seq, err := r.parseCursor(cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		oldest := r.seq - uint64(len(r.kept))
+		if seq < oldest || seq > r.seq {
+			return nil, nil, ErrCursorExpired
+		}
+		replay = r.kept[len(r.kept)-int(r.seq-seq):]
+	}
+
+	s := &adviceSubscription{
+		filter:  newAdviceMatcher(filter),
+		advices: make(chan PublishedAdvice, adviceSubscriptionBuffer+len(replay)),
+	}
+	for i := range replay {
+		if s.filter.match(replay[i].Advice) {
+			s.advices <- replay[i]
+		}
+	}
+	r.subscriptions[s] = true
+
+	return s.advices, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.subscriptions[s] {
+			delete(r.subscriptions, s)
+			close(s.advices)
+		}
+	}, nil
+}
+
+func (r *adviceBroadcaster) cursor(seq uint64) string {
+	return fmt.Sprintf("%d-%d", r.epoch, seq)
+}
+
+func (r *adviceBroadcaster) parseCursor(cursor string) (uint64, error) {
+	parts := strings.SplitN(cursor, "-", 2)
+	if len(parts) != 2 {
+		return 0, errors.Errorf("invalid cursor %q", cursor)
+	}
+
+	epoch, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid cursor %q", cursor)
+	}
+	seq, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid cursor %q", cursor)
+	}
+	if epoch != r.epoch {
+		return 0, ErrCursorExpired
+	}
+
+	return seq, nil
+}
+
+type adviceMatcher struct {
+	symbols      map[string]bool
+	adviserTypes map[advice.AdviserType]bool
+	diagnostics  bool
+}
+
+func newAdviceMatcher(filter AdviceFilter) adviceMatcher {
+	m := adviceMatcher{
+		symbols:      make(map[string]bool, len(filter.Symbols)),
+		adviserTypes: make(map[advice.AdviserType]bool, len(filter.AdviserTypes)),
+		diagnostics:  filter.Diagnostics,
+	}
+	for _, symbol := range filter.Symbols {
+		m.symbols[symbol] = true
+	}
+	for _, t := range filter.AdviserTypes {
+		m.adviserTypes[t] = true
+	}
+
+	return m
+}
+
+func (r adviceMatcher) match(a advice.Advice) bool {
+	return r.matchSymbol(a.Quote.Symbol) &&
+		(len(r.adviserTypes) == 0 || r.adviserTypes[a.AdviserType]) &&
+		(r.diagnostics || a.Status == advice.StatusOK)
+}
+
+func (r adviceMatcher) matchSymbol(symbol string) bool {
+	return len(r.symbols) == 0 || r.symbols[symbol]
+}
+
+func (r adviceMatcher) filter(advices []advice.Advice) []advice.Advice {
+	var result []advice.Advice
+	for i := range advices {
+		if r.match(advices[i]) {
+			result = append(result, advices[i])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/quote"
+)
+
+func testAdvice(symbol string, t advice.AdviserType, status advice.Status) advice.Advice {
+	return advice.Advice{Quote: quote.Quote{Symbol: symbol}, AdviserType: t, Status: status}
+}
+
+func receiveAll(advices <-chan PublishedAdvice) []PublishedAdvice {
+	var received []PublishedAdvice
+	for len(advices) > 0 {
+		received = append(received, <-advices)
+	}
+
+	return received
+}
+
+func TestAdviceBroadcaster_Subscribe_ReplaysAfterCursor(t *testing.T) {
+	b := NewAdviceBroadcaster()
+	live, cancel := mustSubscribe(t, b, AdviceFilter{}, "")
+	defer cancel()
+
+	b.Publish([]advice.Advice{
+		testAdvice("AAPL", advice.AdviserTypeCBS, advice.StatusOK),
+		testAdvice("MSFT", advice.AdviserTypeCBS, advice.StatusOK),
+		testAdvice("TSLA", advice.AdviserTypeCBS, advice.StatusOK),
+	})
+	published := receiveAll(live)
+	if len(published) != 3 {
+		t.Fatalf("expected 3 advices, got %d", len(published))
+	}
+
+	resumed, cancelResumed := mustSubscribe(t, b, AdviceFilter{}, published[0].Cursor)
+	defer cancelResumed()
+	replayed := receiveAll(resumed)
+	if len(replayed) != 2 || replayed[0].Cursor != published[1].Cursor || replayed[1].Cursor != published[2].Cursor {
+		t.Errorf("expected the advices after the cursor, got %v", replayed)
+	}
+
+	latest, cancelLatest := mustSubscribe(t, b, AdviceFilter{}, published[2].Cursor)
+	defer cancelLatest()
+	if len(latest) != 0 {
+		t.Errorf("expected nothing to replay after the last cursor, got %d", len(latest))
+	}
+}
+
+func TestAdviceBroadcaster_Subscribe_ExpiredCursor(t *testing.T) {
+	b := NewAdviceBroadcaster()
+	s, cancel := mustSubscribe(t, b, AdviceFilter{}, "")
+	defer cancel()
+
+	// the advice after the first one is dropped too, so there is nothing to resume from
+	advices := make([]advice.Advice, keptAdvices+1)
+	for i := range advices {
+		advices[i] = testAdvice("AAPL", advice.AdviserTypeCBS, advice.StatusOK)
+	}
+	b.Publish(advices[:1])
+	first := (<-s).Cursor
+	b.Publish(advices)
+
+	if _, _, err := b.Subscribe(AdviceFilter{}, first); err != ErrCursorExpired {
+		t.Errorf("expected the cursor of a dropped advice to expire, got %v", err)
+	}
+
+	// a cursor of another run of the service
+	restarted := NewAdviceBroadcaster()
+	if _, _, err := restarted.Subscribe(AdviceFilter{}, first); err != ErrCursorExpired {
+		t.Errorf("expected the cursor of another run to expire, got %v", err)
+	}
+
+	if _, _, err := b.Subscribe(AdviceFilter{}, "garbage"); err == nil || err == ErrCursorExpired {
+		t.Errorf("expected an invalid cursor error, got %v", err)
+	}
+}
+
+func TestAdviceBroadcaster_Subscribe_Filter(t *testing.T) {
+	b := NewAdviceBroadcaster()
+	s, cancel := mustSubscribe(t, b, AdviceFilter{
+		Symbols:      []string{"AAPL"},
+		AdviserTypes: []advice.AdviserType{advice.AdviserTypeCBS},
+	}, "")
+	defer cancel()
+	diagnostics, cancelDiagnostics := mustSubscribe(t, b, AdviceFilter{Diagnostics: true}, "")
+	defer cancelDiagnostics()
+
+	b.Publish([]advice.Advice{
+		testAdvice("AAPL", advice.AdviserTypeCBS, advice.StatusOK),
+		testAdvice("MSFT", advice.AdviserTypeCBS, advice.StatusOK),
+		testAdvice("AAPL", advice.AdviserTypeFT, advice.StatusOK),
+		testAdvice("AAPL", advice.AdviserTypeCBS, advice.Status("no_trade")),
+	})
+
+	matched := receiveAll(s)
+	if len(matched) != 1 || matched[0].Advice.Quote.Symbol != "AAPL" || matched[0].Advice.AdviserType != advice.AdviserTypeCBS {
+		t.Errorf("expected only the ok AAPL advice of CBS, got %v", matched)
+	}
+	if all := receiveAll(diagnostics); len(all) != 4 {
+		t.Errorf("expected all 4 advices with the diagnostics, got %d", len(all))
+	}
+}
+
+func mustSubscribe(t *testing.T, b AdviceBroadcaster, filter AdviceFilter, cursor string) (<-chan PublishedAdvice, func()) {
+	advices, cancel, err := b.Subscribe(filter, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return advices, cancel
+}
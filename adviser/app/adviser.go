@@ -6,25 +6,82 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics"
-	"github.com/shopspring/decimal"
+	"github.com/pkg/errors"
 
 	"github.com/websmee/example_of_my_code/adviser/domain/advice"
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
 	"github.com/websmee/example_of_my_code/adviser/domain/params"
 	"github.com/websmee/example_of_my_code/adviser/domain/quote"
+	"github.com/websmee/example_of_my_code/calendar"
 )
 
 type AdviserApp interface {
-	GetAdvices(ctx context.Context) ([]advice.Advice, error)
+	// GetAdvices computes the advices at the close of the last bar before the time, now if it is zero.
+	GetAdvices(ctx context.Context, filter AdviceFilter, at time.Time) ([]advice.Advice, error)
+	// WatchCandlesticks computes the advices of every quote as soon as its hourly bar closes,
+	// until the context is done or the subscription breaks.
+	WatchCandlesticks(ctx context.Context) error
+	// SubscribeAdvices sends the advices as they are produced, see AdviceBroadcaster.Subscribe.
+	SubscribeAdvices(filter AdviceFilter, cursor string) (advices <-chan PublishedAdvice, cancel func(), err error)
+	// WatchParams swaps the params of every adviser for the ones made live, until the context is done.
+	// The params not valid for the adviser are rejected, the previous ones stay live.
+	WatchParams(ctx context.Context) error
+	// HealthCheck tells if every adviser has live params.
 	HealthCheck() bool
 }
 
+// preloadPeriod covers the candlesticks the advisers look back at, older ones are requested separately
+const preloadPeriod = 30 * 24 * time.Hour
+
+// adviserFactory lets every GetAdvices call bind the advisers to its own preloaded candlesticks
+type adviserFactory func(candlestickRepository candlestick.Repository) advice.Adviser
+
+var adviserFactories = map[advice.AdviserType]adviserFactory{
+	advice.AdviserTypeCBS: func(candlestickRepository candlestick.Repository) advice.Adviser {
+		return advice.NewCBSAdviser(candlestickRepository, candlestick.NewDefaultCalculator())
+	},
+	advice.AdviserTypeCBSScaled: func(candlestickRepository candlestick.Repository) advice.Adviser {
+		return advice.NewCBSScaledAdviser(candlestickRepository, candlestick.NewDefaultCalculator())
+	},
+	advice.AdviserTypeFT: func(candlestickRepository candlestick.Repository) advice.Adviser {
+		return advice.NewFTAdviser(candlestickRepository, candlestick.NewDefaultCalculator())
+	},
+}
+
+// GetParamsSchema returns the schema of the params the adviser of the type runs by.
+func GetParamsSchema(t advice.AdviserType) (advice.ParamsSchema, error) {
+	newAdviser, ok := adviserFactories[t]
+	if !ok {
+		return nil, errors.Errorf("no adviser of type %s", t)
+	}
+
+	// the schema doesn't depend on the candlesticks
+	return newAdviser(nil).ParamsSchema(), nil
+}
+
+// AdviserConfig enables an adviser in the service, its live params are watched by the name.
+type AdviserConfig struct {
+	Type          advice.AdviserType
+	ParamsName    string
+	ParamsWatcher params.Watcher
+}
+
+type enabledAdviser struct {
+	AdviserConfig
+	newAdviser adviserFactory
+}
+
 type adviserApp struct {
+	logger                log.Logger
 	counter               metrics.Counter
 	quoteRepository       quote.Repository
 	candlestickRepository candlestick.Repository
-	advisers              map[advice.AdviserType]advice.Adviser
-	paramsRepository      params.Repository
+	subscriber            candlestick.Subscriber
+	advisers              map[advice.AdviserType]enabledAdviser
+	paramsChanges         metrics.Counter
+	params                *liveParams
+	closed                *closedBarAdvices
+	broadcaster           AdviceBroadcaster
 }
 
 func NewAdviserApp(
@@ -32,18 +89,29 @@ func NewAdviserApp(
 	counter metrics.Counter,
 	quoteRepository quote.Repository,
 	candlestickRepository candlestick.Repository,
-	paramsRepository params.Repository,
+	subscriber candlestick.Subscriber,
+	adviserConfigs []AdviserConfig,
+	paramsChanges metrics.Counter,
 ) AdviserApp {
+	candlestickRepository = candlestick.NewBasicFilter(candlestickRepository)
+	advisers := make(map[advice.AdviserType]enabledAdviser, len(adviserConfigs))
+	for _, c := range adviserConfigs {
+		advisers[c.Type] = enabledAdviser{AdviserConfig: c, newAdviser: adviserFactories[c.Type]}
+	}
+
 	var svc AdviserApp
 	{
 		svc = &adviserApp{
+			logger:                logger,
 			counter:               counter,
 			quoteRepository:       quoteRepository,
 			candlestickRepository: candlestickRepository,
-			advisers: map[advice.AdviserType]advice.Adviser{
-				advice.AdviserTypeCBS: advice.NewCBSScaledAdviser(candlestickRepository, candlestick.NewDefaultCalculator()),
-			},
-			paramsRepository: paramsRepository,
+			subscriber:            subscriber,
+			advisers:              advisers,
+			paramsChanges:         paramsChanges,
+			params:                newLiveParams(),
+			closed:                newClosedBarAdvices(),
+			broadcaster:           NewAdviceBroadcaster(),
 		}
 		svc = AdviserLoggingMiddleware(logger)(svc)
 		svc = AdviserInstrumentingMiddleware(counter)(svc)
@@ -51,65 +119,308 @@ func NewAdviserApp(
 	return svc
 }
 
-func (r adviserApp) GetAdvices(ctx context.Context) ([]advice.Advice, error) {
+// GetAdvices computes only the advices of the bars WatchCandlesticks hasn't got to yet.
+// All the advisers run for the computed bars, so the kept advices are complete whatever the filter is.
+func (r adviserApp) GetAdvices(ctx context.Context, filter AdviceFilter, at time.Time) ([]advice.Advice, error) {
+	now := time.Now()
+	live := at.IsZero()
+	if live {
+		at = now
+	}
+	if at.After(now) {
+		return nil, errors.New("advices can't be computed in the future")
+	}
+
+	quotes, err := r.quoteRepository.GetQuotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := quote.NewCalendars(quotes)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := newAdviceMatcher(filter)
 	var advices []advice.Advice
-	for t, adviser := range r.advisers {
-		adviserParams, err := r.paramsRepository.LoadParams(string(t))
+	var pending []quote.Quote
+	var pendingBars []calendar.Bar
+	for i := range quotes {
+		if !matcher.matchSymbol(quotes[i].Symbol) {
+			continue
+		}
+
+		bar, ok := calendar.LastClosedBar(calendars.Get(quotes[i].Symbol), candlestick.IntervalHour.Duration(), at)
+		if !ok {
+			continue
+		}
+
+		if cached, ok := r.closed.get(quotes[i].Symbol, bar); ok {
+			advices = append(advices, matcher.filter(cached)...)
+			continue
+		}
+		pending = append(pending, quotes[i])
+		pendingBars = append(pendingBars, bar)
+	}
+	if len(pending) == 0 {
+		return advices, nil
+	}
+
+	symbols := make([]string, len(pending))
+	for i := range pending {
+		symbols[i] = pending[i].Symbol
+	}
+
+	// the preloaded repository isn't wrapped by candlestick.NewCalendarGetter any more, the counts it can't serve
+	// go to the LIMIT query of the quotes service, which returns exactly count candlesticks without guessing the range
+	preloaded, err := candlestick.NewPreloadedRepository(ctx, r.candlestickRepository, symbols, candlestick.IntervalHour, at.Add(-preloadPeriod), at)
+	if err != nil {
+		return nil, err
+	}
+
+	liveSet := r.loadParams()
+
+	for i := range pending {
+		a, err := r.advise(ctx, preloaded, liveSet, pending[i], calendars.Get(pending[i].Symbol), pendingBars[i], live)
 		if err != nil {
 			return nil, err
 		}
+		advices = append(advices, matcher.filter(a)...)
+	}
+
+	return advices, nil
+}
 
+// WatchCandlesticks subscribes to all the quotes, the ones created later are looked up as their first candlestick comes.
+func (r adviserApp) WatchCandlesticks(ctx context.Context) error {
+	var bySymbol map[string]quote.Quote
+	var calendars calendar.Calendars
+	refresh := func() error {
 		quotes, err := r.quoteRepository.GetQuotes(ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
+		calendars, err = quote.NewCalendars(quotes)
+		if err != nil {
+			return err
+		}
+
+		bySymbol = make(map[string]quote.Quote, len(quotes))
 		for i := range quotes {
-			current, err := r.candlestickRepository.GetCandlesticks(
-				ctx, quotes[i].Symbol,
-				candlestick.IntervalHour,
-				time.Now().Add(-2*time.Hour),
-				time.Now().Add(-time.Hour),
-			)
-			if err != nil {
-				return nil, err
+			bySymbol[quotes[i].Symbol] = quotes[i]
+		}
+		return nil
+	}
+	if err := refresh(); err != nil {
+		return err
+	}
+
+	interval := candlestick.IntervalHour
+	return r.subscriber.SubscribeCandlesticks(ctx, nil, interval, func(symbol string, c candlestick.Candlestick) error {
+		q, ok := bySymbol[symbol]
+		if !ok {
+			if err := refresh(); err != nil {
+				_ = r.logger.Log("method", "WatchCandlesticks", "symbol", symbol, "error", err)
+				return nil
 			}
-			if len(current) != 1 {
-				continue
+			if q, ok = bySymbol[symbol]; !ok {
+				return nil
 			}
+		}
 
-			a, err := adviser.GetAdvices(ctx, adviserParams, current[0], quotes[i].Symbol)
-			if err != nil {
-				return nil, err
-			}
-			advices = append(advices, r.convertAdvices(a, quotes[i])...)
+		// the bar the candlestick closes, the session close may cut it short
+		bar, ok := calendar.LastClosedBar(calendars.Get(symbol), interval.Duration(), c.Timestamp.Add(interval.Duration()))
+		if !ok {
+			return nil
+		}
+
+		// one quote failing shouldn't keep the others waiting for the next subscription
+		advices, err := r.adviseClosed(ctx, q, calendars.Get(symbol), bar)
+		_ = r.logger.Log("method", "WatchCandlesticks", "symbol", symbol, "bar", bar.Start, "advices", len(advices), "error", err)
+		if err == nil {
+			r.counter.Add(float64(len(advices)))
+		}
+		return nil
+	})
+}
+
+func (r adviserApp) adviseClosed(ctx context.Context, q quote.Quote, cal calendar.Calendar, bar calendar.Bar) ([]advice.Advice, error) {
+	now := time.Now()
+	preloaded, err := candlestick.NewPreloadedRepository(ctx, r.candlestickRepository, []string{q.Symbol}, candlestick.IntervalHour, now.Add(-preloadPeriod), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.advise(ctx, preloaded, r.loadParams(), q, cal, bar, true)
+}
+
+// advise runs every adviser at the close of the bar. The live advices are kept for the later requests,
+// the ones of a bar new to the service are published unless the params have changed meanwhile.
+func (r adviserApp) advise(
+	ctx context.Context,
+	candlestickRepository candlestick.Repository,
+	liveSet paramsSet,
+	q quote.Quote,
+	cal calendar.Calendar,
+	bar calendar.Bar,
+	live bool,
+) ([]advice.Advice, error) {
+	current, err := candlestickRepository.GetCandlesticks(
+		ctx, q.Symbol,
+		candlestick.IntervalHour,
+		bar.Start,
+		bar.End.Add(-time.Second),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) == 0 {
+		return nil, nil
+	}
+
+	adviserParams := liveSet.byType
+	var advices []advice.Advice
+	for t, enabled := range r.advisers {
+		if adviserParams[t] == nil {
+			continue
+		}
+		a, err := enabled.newAdviser(candlestickRepository).GetAdvices(ctx, adviserParams[t].Document.Params, current[len(current)-1], q.Symbol)
+		if err != nil {
+			return nil, err
 		}
+		converted, err := r.convertAdvices(ctx, candlestickRepository, a, q, t, adviserParams[t].Number, cal, bar)
+		if err != nil {
+			return nil, err
+		}
+		advices = append(advices, converted...)
+	}
+
+	if live && r.closed.set(q.Symbol, bar, liveSet.generation, advices) {
+		r.broadcaster.Publish(advices)
 	}
 
 	return advices, nil
 }
 
-func (r adviserApp) convertAdvices(internal []advice.InternalAdvice, quote quote.Quote) []advice.Advice {
+func (r adviserApp) SubscribeAdvices(filter AdviceFilter, cursor string) (<-chan PublishedAdvice, func(), error) {
+	return r.broadcaster.Subscribe(filter, cursor)
+}
+
+// loadParams gets the live params of every adviser, the ones without live params are skipped by advise until
+// their params are promoted, the others go on
+func (r adviserApp) loadParams() paramsSet {
+	liveSet := r.params.loadSet()
+	for t, enabled := range r.advisers {
+		if liveSet.byType[t] == nil {
+			_ = r.logger.Log("method", "loadParams", "adviser", t, "params", enabled.ParamsName, "error", "no params promoted, the adviser is skipped")
+		}
+	}
+
+	return liveSet
+}
+
+// WatchParams watches the params of every adviser by its own watcher, the first one failing stops the others
+func (r adviserApp) WatchParams(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make(chan error, len(r.advisers))
+	for t, enabled := range r.advisers {
+		go func(t advice.AdviserType, c AdviserConfig, schema advice.ParamsSchema) {
+			errs <- c.ParamsWatcher.Watch(ctx, c.ParamsName, func(v *params.Version, err error) {
+				r.changeParams(t, c.ParamsName, schema, v, err)
+			})
+		}(t, enabled.AdviserConfig, enabled.newAdviser(r.candlestickRepository).ParamsSchema())
+	}
+
+	var first error
+	for range r.advisers {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+			cancel()
+		}
+	}
+
+	return first
+}
+
+// changeParams rejects the params of another adviser, the legacy ones have no adviser type and are checked by the schema only
+func (r adviserApp) changeParams(t advice.AdviserType, name string, schema advice.ParamsSchema, v *params.Version, err error) {
+	if err == nil && v.Document.AdviserType != "" && v.Document.AdviserType != t {
+		err = errors.Errorf("version %d of params %s is of the adviser %s", v.Number, name, v.Document.AdviserType)
+	}
+	if err == nil {
+		var validated advice.Params
+		validated, err = schema.Validate(v.Document.Params)
+		if err != nil {
+			err = errors.Wrapf(err, "version %d of params %s rejected", v.Number, name)
+		} else {
+			// the watcher's version is left as it is, the validated params go to a copy
+			checked := *v
+			checked.Document.Params = validated
+			v = &checked
+		}
+	}
+	if err != nil {
+		_ = r.logger.Log("method", "WatchParams", "adviser", t, "params", name, "error", err)
+		r.paramsChanges.With("adviser_type", string(t), "status", "rejected").Add(1)
+		return
+	}
+
+	previous := 0
+	if replaced := r.params.swap(t, v); replaced != nil {
+		previous = replaced.Number
+	}
+	r.closed.clear(r.params.loadSet().generation)
+	_ = r.logger.Log("method", "WatchParams", "adviser", t, "params", name, "version", v.Number, "previous", previous, "values", v.Document.Params)
+	r.paramsChanges.With("adviser_type", string(t), "status", "applied").Add(1)
+}
+
+// convertAdvices adds the HoursBefore candlesticks up to the current one for the context,
+// the orders expire at the end of the HoursAfter trading hours after the bar.
+func (r adviserApp) convertAdvices(
+	ctx context.Context,
+	candlestickRepository candlestick.Repository,
+	internal []advice.InternalAdvice,
+	q quote.Quote,
+	adviserType advice.AdviserType,
+	paramsVersion int,
+	cal calendar.Calendar,
+	bar calendar.Bar,
+) ([]advice.Advice, error) {
 	advices := make([]advice.Advice, len(internal))
 	for i := range internal {
-		advices[i] = advice.Advice{
-			Quote:            quote,
-			Candlesticks:     nil, //todo
-			Price:            internal[i].CurrentPrice,
-			Amount:           decimal.NewFromInt(0), //todo
-			TakeProfitPrice:  internal[i].TakeProfit,
-			TakeProfitAmount: decimal.NewFromInt(0), //todo
-			StopLossPrice:    internal[i].StopLoss,
-			StopLossAmount:   decimal.NewFromInt(0), //todo
-			Leverage:         0,                     //todo
-			ExpiresAt:        time.Time{},           //todo
+		candlesticks, err := candlestickRepository.GetCandlesticksByCount(
+			ctx, q.Symbol,
+			candlestick.IntervalHour,
+			internal[i].Timestamp,
+			candlestick.GetterDirectionBackward,
+			internal[i].HoursBefore+1,
+		)
+		if err != nil {
+			return nil, err
 		}
+
+		expiresAt := bar.End
+		if after := calendar.BarsAfter(cal, candlestick.IntervalHour.Duration(), bar.End, internal[i].HoursAfter); len(after) > 0 {
+			expiresAt = after[len(after)-1].End
+		}
+
+		advices[i] = advice.NewAdvice(internal[i], q, adviserType, candlesticks, expiresAt)
+		advices[i].ParamsVersion = paramsVersion
 	}
 
-	return advices
+	return advices, nil
 }
 
 func (r adviserApp) HealthCheck() bool {
-	//todo
+	adviserParams := r.params.load()
+	for t := range r.advisers {
+		if adviserParams[t] == nil {
+			return false
+		}
+	}
+
 	return true
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics"
@@ -22,11 +23,32 @@ type adviserLoggingMiddleware struct {
 	next   AdviserApp
 }
 
-func (mw adviserLoggingMiddleware) GetAdvices(ctx context.Context) (advices []advice.Advice, err error) {
+func (mw adviserLoggingMiddleware) GetAdvices(ctx context.Context, filter AdviceFilter, at time.Time) (advices []advice.Advice, err error) {
 	defer func() {
-		_ = mw.logger.Log("method", "GetAdvices", "error", err)
+		_ = mw.logger.Log("method", "GetAdvices", "symbols", len(filter.Symbols), "at", at, "advices", len(advices), "error", err)
 	}()
-	return mw.next.GetAdvices(ctx)
+	return mw.next.GetAdvices(ctx, filter, at)
+}
+
+func (mw adviserLoggingMiddleware) WatchCandlesticks(ctx context.Context) (err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "WatchCandlesticks", "error", err)
+	}()
+	return mw.next.WatchCandlesticks(ctx)
+}
+
+func (mw adviserLoggingMiddleware) SubscribeAdvices(filter AdviceFilter, cursor string) (advices <-chan PublishedAdvice, cancel func(), err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "SubscribeAdvices", "symbols", len(filter.Symbols), "cursor", cursor, "error", err)
+	}()
+	return mw.next.SubscribeAdvices(filter, cursor)
+}
+
+func (mw adviserLoggingMiddleware) WatchParams(ctx context.Context) (err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "WatchParams", "error", err)
+	}()
+	return mw.next.WatchParams(ctx)
 }
 
 func (mw adviserLoggingMiddleware) HealthCheck() bool {
@@ -47,12 +69,26 @@ type adviserInstrumentingMiddleware struct {
 	next    AdviserApp
 }
 
-func (mw adviserInstrumentingMiddleware) GetAdvices(ctx context.Context) (advices []advice.Advice, err error) {
-	advices, err = mw.next.GetAdvices(ctx)
+func (mw adviserInstrumentingMiddleware) GetAdvices(ctx context.Context, filter AdviceFilter, at time.Time) (advices []advice.Advice, err error) {
+	advices, err = mw.next.GetAdvices(ctx, filter, at)
 	mw.counter.Add(float64(len(advices)))
 	return
 }
 
+// WatchCandlesticks counts the advices itself, it doesn't return them
+func (mw adviserInstrumentingMiddleware) WatchCandlesticks(ctx context.Context) error {
+	return mw.next.WatchCandlesticks(ctx)
+}
+
+func (mw adviserInstrumentingMiddleware) SubscribeAdvices(filter AdviceFilter, cursor string) (<-chan PublishedAdvice, func(), error) {
+	return mw.next.SubscribeAdvices(filter, cursor)
+}
+
+// WatchParams counts the changes itself
+func (mw adviserInstrumentingMiddleware) WatchParams(ctx context.Context) error {
+	return mw.next.WatchParams(ctx)
+}
+
 func (mw adviserInstrumentingMiddleware) HealthCheck() bool {
 	return mw.next.HealthCheck()
 }
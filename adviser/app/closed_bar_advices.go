@@ -0,0 +1,71 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+// closedBarAdvices keeps the advices computed at the close of the last bar of every quote.
+// The advices are tagged with the generation of the params they were computed by, see liveParams.
+type closedBarAdvices struct {
+	mu         sync.RWMutex
+	generation uint64
+	bySymbol   map[string]barAdvices
+}
+
+type barAdvices struct {
+	bar        calendar.Bar
+	advices    []advice.Advice
+	generation uint64
+}
+
+func newClosedBarAdvices() *closedBarAdvices {
+	return &closedBarAdvices{
+		bySymbol: make(map[string]barAdvices),
+	}
+}
+
+func (r *closedBarAdvices) get(symbol string, bar calendar.Bar) ([]advice.Advice, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cached, ok := r.bySymbol[symbol]
+	if !ok || !cached.bar.Start.Equal(bar.Start) {
+		return nil, false
+	}
+
+	return cached.advices, true
+}
+
+// set ignores the advices computed by the params replaced since and the ones of a bar not newer than the kept one
+// of the same params, it tells if they are kept
+func (r *closedBarAdvices) set(symbol string, bar calendar.Bar, generation uint64, advices []advice.Advice) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation < r.generation {
+		return false
+	}
+	if cached, ok := r.bySymbol[symbol]; ok && cached.generation >= generation && !cached.bar.Start.Before(bar.Start) {
+		return false
+	}
+	r.bySymbol[symbol] = barAdvices{bar: bar, advices: advices, generation: generation}
+	return true
+}
+
+// clear drops the advices computed by the params older than the generation, they aren't live anymore
+func (r *closedBarAdvices) clear(generation uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation > r.generation {
+		r.generation = generation
+	}
+	for symbol, cached := range r.bySymbol {
+		if cached.generation < r.generation {
+			delete(r.bySymbol, symbol)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+func hourBar(hour int) calendar.Bar {
+	start := time.Date(2021, 3, 1, hour, 0, 0, 0, time.UTC)
+	return calendar.Bar{Start: start, End: start.Add(time.Hour)}
+}
+
+func TestClosedBarAdvices_Set(t *testing.T) {
+	closed := newClosedBarAdvices()
+	advices := []advice.Advice{{}}
+
+	if !closed.set("AAPL", hourBar(10), 0, advices) {
+		t.Fatal("the first bar isn't kept")
+	}
+	if closed.set("AAPL", hourBar(10), 0, nil) || closed.set("AAPL", hourBar(9), 0, nil) {
+		t.Error("a bar not newer than the kept one is kept")
+	}
+	if cached, ok := closed.get("AAPL", hourBar(10)); !ok || len(cached) != 1 {
+		t.Error("the kept advices are replaced", cached, ok)
+	}
+
+	if !closed.set("AAPL", hourBar(11), 0, nil) {
+		t.Error("a newer bar isn't kept")
+	}
+	if _, ok := closed.get("AAPL", hourBar(10)); ok {
+		t.Error("the advices of an older bar are returned")
+	}
+	if _, ok := closed.get("MSFT", hourBar(11)); ok {
+		t.Error("the advices of another symbol are returned")
+	}
+}
+
+func TestClosedBarAdvices_Generations(t *testing.T) {
+	closed := newClosedBarAdvices()
+	closed.set("AAPL", hourBar(10), 0, []advice.Advice{{}})
+	closed.set("MSFT", hourBar(10), 1, []advice.Advice{{}})
+
+	closed.clear(1)
+	if _, ok := closed.get("AAPL", hourBar(10)); ok {
+		t.Error("the advices of the replaced params are returned")
+	}
+	if _, ok := closed.get("MSFT", hourBar(10)); !ok {
+		t.Error("the advices of the live params are dropped")
+	}
+
+	// an advise started before the params change finishes after it
+	if closed.set("AAPL", hourBar(11), 0, nil) {
+		t.Error("the advices of the replaced params are kept")
+	}
+	if !closed.set("MSFT", hourBar(10), 2, nil) {
+		t.Error("the advices of newer params aren't kept for the same bar")
+	}
+}
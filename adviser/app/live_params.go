@@ -0,0 +1,52 @@
+package app
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+// liveParams keeps the live params of every adviser. The set is replaced as a whole,
+// so a computation loading it once runs every adviser by the params live at the same moment.
+type liveParams struct {
+	mu      sync.Mutex // serializes the writers, the readers don't lock
+	current atomic.Value
+}
+
+// paramsSet is the params of every adviser, the generation grows with every swap
+type paramsSet struct {
+	byType     map[advice.AdviserType]*params.Version
+	generation uint64
+}
+
+func newLiveParams() *liveParams {
+	r := &liveParams{}
+	r.current.Store(paramsSet{byType: map[advice.AdviserType]*params.Version{}})
+	return r
+}
+
+func (r *liveParams) load() map[advice.AdviserType]*params.Version {
+	return r.loadSet().byType
+}
+
+func (r *liveParams) loadSet() paramsSet {
+	return r.current.Load().(paramsSet)
+}
+
+// swap sets the params of the adviser, it returns the ones replaced, nil if there were none
+func (r *liveParams) swap(t advice.AdviserType, v *params.Version) *params.Version {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.loadSet()
+	updated := make(map[advice.AdviserType]*params.Version, len(current.byType)+1)
+	for k, cv := range current.byType {
+		updated[k] = cv
+	}
+	updated[t] = v
+	r.current.Store(paramsSet{byType: updated, generation: current.generation + 1})
+
+	return current.byType[t]
+}
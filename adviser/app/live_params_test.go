@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+func cbsVersion(number int, calmDurationHours int64) *params.Version {
+	return &params.Version{
+		Name:   "CBS",
+		Number: number,
+		Document: params.Document{
+			AdviserType: advice.AdviserTypeCBS,
+			Params:      advice.Params{"calm_duration_hours": decimal.NewFromInt(calmDurationHours)},
+		},
+	}
+}
+
+func TestLiveParams_Swap(t *testing.T) {
+	live := newLiveParams()
+
+	if replaced := live.swap(advice.AdviserTypeCBS, cbsVersion(1, 10)); replaced != nil {
+		t.Errorf("expected nothing replaced, got %v", replaced)
+	}
+	loaded := live.load()
+
+	if replaced := live.swap(advice.AdviserTypeCBS, cbsVersion(2, 20)); replaced == nil || replaced.Number != 1 {
+		t.Errorf("expected version 1 replaced, got %v", replaced)
+	}
+	live.swap(advice.AdviserTypeFT, &params.Version{Name: "FT", Number: 1})
+
+	// the set loaded before keeps the params it was loaded with
+	if len(loaded) != 1 || loaded[advice.AdviserTypeCBS].Number != 1 {
+		t.Errorf("expected the loaded set untouched, got %v", loaded)
+	}
+	if generation := live.loadSet().generation; generation != 3 {
+		t.Errorf("expected generation 3 after 3 swaps, got %d", generation)
+	}
+	if current := live.load(); len(current) != 2 || current[advice.AdviserTypeCBS].Number != 2 || current[advice.AdviserTypeFT].Number != 1 {
+		t.Errorf("expected CBS 2 and FT 1 live, got %v", current)
+	}
+}
+
+func newTestParamsApp() *adviserApp {
+	return &adviserApp{
+		logger:        log.NewNopLogger(),
+		paramsChanges: discard.NewCounter(),
+		params:        newLiveParams(),
+		closed:        newClosedBarAdvices(),
+	}
+}
+
+func TestAdviserApp_ChangeParams(t *testing.T) {
+	r := newTestParamsApp()
+	schema := advice.CBSParamsSchema
+
+	watched := cbsVersion(1, 12)
+	r.changeParams(advice.AdviserTypeCBS, "CBS", schema, watched, nil)
+	live := r.params.load()[advice.AdviserTypeCBS]
+	if live == nil || live.Number != 1 || live.Document.Params.Int("calm_duration_hours") != 12 {
+		t.Fatalf("expected version 1 live, got %v", live)
+	}
+	// the missing params are completed by the defaults on a copy
+	if len(live.Document.Params) != len(schema) || len(watched.Document.Params) != 1 {
+		t.Errorf("expected the validated params on a copy, got %v and %v", live.Document.Params, watched.Document.Params)
+	}
+
+	// the advices computed by the replaced params are dropped
+	r.closed.set("AAPL", hourBar(10), r.params.loadSet().generation, []advice.Advice{{}})
+	r.changeParams(advice.AdviserTypeCBS, "CBS", schema, cbsVersion(2, 14), nil)
+	if live := r.params.load()[advice.AdviserTypeCBS]; live.Number != 2 {
+		t.Errorf("expected version 2 live, got %d", live.Number)
+	}
+	if _, ok := r.closed.get("AAPL", hourBar(10)); ok {
+		t.Error("expected the kept advices dropped on a params change")
+	}
+
+	// invalid params, the params of another adviser and watcher errors leave the live ones as they are
+	scaled := cbsVersion(4, 12)
+	scaled.Document.AdviserType = advice.AdviserTypeCBSScaled
+	r.changeParams(advice.AdviserTypeCBS, "CBS", schema, scaled, nil)
+	r.changeParams(advice.AdviserTypeCBS, "CBS", schema, cbsVersion(3, 0), nil)
+	r.changeParams(advice.AdviserTypeCBS, "CBS", schema, nil, errors.New("unreadable"))
+	if live := r.params.load()[advice.AdviserTypeCBS]; live.Number != 2 {
+		t.Errorf("expected version 2 still live, got %d", live.Number)
+	}
+}
+
+func TestAdviserApp_LoadParams_SkipsMissing(t *testing.T) {
+	r := newTestParamsApp()
+	r.advisers = map[advice.AdviserType]enabledAdviser{
+		advice.AdviserTypeCBS: {AdviserConfig: AdviserConfig{Type: advice.AdviserTypeCBS, ParamsName: "CBS"}},
+		advice.AdviserTypeFT:  {AdviserConfig: AdviserConfig{Type: advice.AdviserTypeFT, ParamsName: "FT"}},
+	}
+	r.changeParams(advice.AdviserTypeCBS, "CBS", advice.CBSParamsSchema, cbsVersion(1, 12), nil)
+
+	adviserParams := r.loadParams().byType
+	if adviserParams[advice.AdviserTypeCBS] == nil || adviserParams[advice.AdviserTypeFT] != nil {
+		t.Errorf("expected the params of CBS only, got %v", adviserParams)
+	}
+	if r.HealthCheck() {
+		t.Error("expected unhealthy while FT has no live params")
+	}
+
+	r.changeParams(advice.AdviserTypeFT, "FT", advice.FTParamsSchema, &params.Version{Name: "FT", Number: 1}, nil)
+	if !r.HealthCheck() {
+		t.Error("expected healthy once every adviser has live params")
+	}
+}
+
+// stubParamsWatcher fails with err, or watches till the context is done if there is none
+type stubParamsWatcher struct {
+	err error
+}
+
+func (r stubParamsWatcher) Watch(ctx context.Context, _ string, _ func(v *params.Version, err error)) error {
+	if r.err != nil {
+		return r.err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestAdviserApp_WatchParams_StopsOnFirstError(t *testing.T) {
+	r := newTestParamsApp()
+	failed := errors.New("consul is gone")
+	r.advisers = map[advice.AdviserType]enabledAdviser{
+		advice.AdviserTypeCBS: {
+			AdviserConfig: AdviserConfig{Type: advice.AdviserTypeCBS, ParamsName: "CBS", ParamsWatcher: stubParamsWatcher{}},
+			newAdviser:    adviserFactories[advice.AdviserTypeCBS],
+		},
+		advice.AdviserTypeFT: {
+			AdviserConfig: AdviserConfig{Type: advice.AdviserTypeFT, ParamsName: "FT", ParamsWatcher: stubParamsWatcher{err: failed}},
+			newAdviser:    adviserFactories[advice.AdviserTypeFT],
+		},
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- r.WatchParams(context.Background()) }()
+
+	select {
+	case err := <-done:
+		if err != failed {
+			t.Errorf("expected the watcher's error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the watchers aren't stopped by the failed one")
+	}
+}
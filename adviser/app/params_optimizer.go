@@ -23,27 +23,29 @@ type ParamsOptimizerApp interface {
 type optimizerApp struct {
 	quoteRepository       quote.Repository
 	candlestickRepository candlestick.Repository
-	paramsRepository      params.Repository
+	paramsRegistry        params.Registry
 	tester                params.AdviserParamsTester
+	adviserType           advice.AdviserType
 	adviser               advice.Adviser
 	calc                  candlestick.Calculator
 	adviceSelector        advice.Selector
-	startParams           []decimal.Decimal
-	minParams             []decimal.Decimal
-	maxParams             []decimal.Decimal
+	startParams           advice.Params
+	minParams             advice.Params
+	maxParams             advice.Params
 	modifyRate            float64
 }
 
 func NewCBSScaledOptimizerApp(
 	quoteRepository quote.Repository,
 	candlestickRepository candlestick.Repository,
-	paramsRepository params.Repository,
+	paramsRegistry params.Registry,
 	modifyRate float64,
 ) ParamsOptimizerApp {
 	return newOptimizerApp(
 		quoteRepository,
 		candlestickRepository,
-		paramsRepository,
+		paramsRegistry,
+		advice.AdviserTypeCBSScaled,
 		advice.NewCBSScaledAdviser(candlestickRepository, candlestick.NewDefaultCalculator()),
 		minCBSScaledParams().GetParams(),
 		minCBSScaledParams().GetParams(),
@@ -55,19 +57,21 @@ func NewCBSScaledOptimizerApp(
 func newOptimizerApp(
 	quoteRepository quote.Repository,
 	candlestickRepository candlestick.Repository,
-	paramsRepository params.Repository,
+	paramsRegistry params.Registry,
+	adviserType advice.AdviserType,
 	adviser advice.Adviser,
-	startParams []decimal.Decimal,
-	minParams []decimal.Decimal,
-	maxParams []decimal.Decimal,
+	startParams advice.Params,
+	minParams advice.Params,
+	maxParams advice.Params,
 	modifyRate float64,
 ) ParamsOptimizerApp {
 	candlestickRepository = candlestick.NewBasicFilter(candlestickRepository)
 	return &optimizerApp{
 		quoteRepository:       quoteRepository,
 		candlestickRepository: candlestickRepository,
-		paramsRepository:      paramsRepository,
+		paramsRegistry:        paramsRegistry,
 		tester:                params.NewAdviserParamsTester(candlestickRepository),
+		adviserType:           adviserType,
 		adviser:               adviser,
 		calc:                  candlestick.NewDefaultCalculator(),
 		adviceSelector:        advice.NewDefaultSelector(),
@@ -79,7 +83,7 @@ func newOptimizerApp(
 }
 
 type paramsStats struct {
-	params    []decimal.Decimal
+	params    advice.Params
 	frequency float64
 	accuracy  float64
 }
@@ -146,9 +150,18 @@ func maxFTParams() *advice.FTParams {
 	}
 }
 
+// OptimizeParams searches the params between the min and the max ones, by the values in the order of the schema.
 func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to time.Time, minFrequency float64) error {
-	modifyingParams := make([]decimal.Decimal, len(r.startParams))
-	copy(modifyingParams, r.startParams)
+	schema := r.adviser.ParamsSchema()
+	var bounds [3][]decimal.Decimal
+	for i, p := range []advice.Params{r.startParams, r.minParams, r.maxParams} {
+		validated, err := schema.Validate(p)
+		if err != nil {
+			return err
+		}
+		bounds[i] = schema.Values(validated)
+	}
+	modifyingParams, minParams, maxParams := bounds[0], bounds[1], bounds[2]
 
 	quotes, err := r.quoteRepository.GetQuotes(ctx)
 	if err != nil {
@@ -161,8 +174,8 @@ func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to
 	}
 
 	modifier := params.NewBruteForceParamsModifier(
-		r.minParams,
-		r.maxParams,
+		minParams,
+		maxParams,
 		decimal.NewFromFloat(r.modifyRate),
 	)
 
@@ -171,6 +184,11 @@ func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to
 	var frequentEnoughStats []paramsStats
 	bar := pb.StartNew(modifier.GetTotalSteps() * testerTotalSteps)
 	for modifier.Modify(modifyingParams) {
+		testing, err := schema.FromValues(modifyingParams)
+		if err != nil {
+			return err
+		}
+
 		var count, advicesOK, accurate, loss, expired int
 		var wg sync.WaitGroup
 		advicesChan := make(chan []advice.InternalAdvice)
@@ -178,7 +196,7 @@ func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to
 			q := quotes[i]
 			wg.Add(1)
 			go func() {
-				r.tester.TestParams(ctx, r.adviser, modifyingParams, q, from, to, advicesChan)
+				r.tester.TestParams(ctx, r.adviser, testing, q, from, to, advicesChan)
 				wg.Done()
 			}()
 		}
@@ -220,11 +238,10 @@ func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to
 		accuracy := float64(accurate) / float64(advicesOK) * 100
 		if frequency >= minFrequency {
 			currentStats = paramsStats{
-				params:    make([]decimal.Decimal, len(modifyingParams)),
+				params:    testing,
 				frequency: frequency,
 				accuracy:  accuracy,
 			}
-			copy(currentStats.params, modifyingParams)
 			if bestStats.accuracy < currentStats.accuracy {
 				bestStats = currentStats
 			} else if bestStats.accuracy == currentStats.accuracy && bestStats.frequency < currentStats.frequency {
@@ -235,10 +252,21 @@ func (r optimizerApp) OptimizeParams(ctx context.Context, name string, from, to
 	}
 	bar.Finish()
 
-	return r.saveResults(name, bestStats, frequentEnoughStats)
+	symbols := make([]string, len(quotes))
+	for i := range quotes {
+		symbols[i] = quotes[i].Symbol
+	}
+
+	return r.saveResults(name, symbols, from, to, bestStats, frequentEnoughStats)
 }
 
-func (r optimizerApp) saveResults(name string, bestStats paramsStats, frequentEnoughStats []paramsStats) error {
+func (r optimizerApp) saveResults(
+	name string,
+	symbols []string,
+	from, to time.Time,
+	bestStats paramsStats,
+	frequentEnoughStats []paramsStats,
+) error {
 	fmt.Println("FREQUENT ENOUGH:")
 	for i := range frequentEnoughStats {
 		frequencyStr := strconv.FormatFloat(frequentEnoughStats[i].frequency, 'f', 2, 64)
@@ -253,17 +281,21 @@ func (r optimizerApp) saveResults(name string, bestStats paramsStats, frequentEn
 	if bestStats.frequency > 0 {
 		frequencyStr := strconv.FormatFloat(bestStats.frequency, 'f', 2, 64)
 		accuracyStr := strconv.FormatFloat(bestStats.accuracy, 'f', 2, 64)
-		if err := r.paramsRepository.SaveParams(
-			name+
-				"_"+
-				frequencyStr+
-				"_"+
-				accuracyStr,
-			bestStats.params,
-		); err != nil {
+		v, err := r.paramsRegistry.AddVersion(name, params.Document{
+			AdviserType: r.adviserType,
+			Params:      bestStats.params,
+			Period:      &params.Period{From: from, To: to},
+			Scores: map[string]float64{
+				params.ScoreFrequency: bestStats.frequency,
+				params.ScoreAccuracy:  bestStats.accuracy,
+			},
+			Symbols:   symbols,
+			CreatedAt: time.Now().UTC(),
+		})
+		if err != nil {
 			return err
 		}
-		fmt.Println(bestStats.params, frequencyStr, accuracyStr)
+		fmt.Println("version", v.Number, bestStats.params, frequencyStr, accuracyStr)
 	} else {
 		fmt.Println("none")
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cheggaaa/pb/v3"
+	"github.com/pkg/errors"
 
 	"github.com/websmee/example_of_my_code/adviser/domain/advice"
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
@@ -17,14 +18,15 @@ import (
 )
 
 type ParamsTesterApp interface {
-	TestParams(ctx context.Context, name string, from, to time.Time) error
+	// TestParams tests the version of the params in the registry, the live one if the version is 0.
+	TestParams(ctx context.Context, name string, version int, from, to time.Time) error
 }
 
 type testerApp struct {
 	tester           params.AdviserParamsTester
 	adviser          advice.Adviser
 	quoteRepository  quote.Repository
-	paramsRepository params.Repository
+	paramsRegistry   params.Registry
 	adviceRepository advice.Repository
 	adviceSelector   advice.Selector
 }
@@ -32,13 +34,13 @@ type testerApp struct {
 func NewCBSScaledTesterApp(
 	quoteRepository quote.Repository,
 	candlestickRepository candlestick.Repository,
-	paramsRepository params.Repository,
+	paramsRegistry params.Registry,
 	adviceRepository advice.Repository,
 ) ParamsTesterApp {
 	return newTesterApp(
 		quoteRepository,
 		candlestickRepository,
-		paramsRepository,
+		paramsRegistry,
 		adviceRepository,
 		advice.NewCBSScaledAdviser(candlestickRepository, candlestick.NewDefaultCalculator()),
 	)
@@ -47,7 +49,7 @@ func NewCBSScaledTesterApp(
 func newTesterApp(
 	quoteRepository quote.Repository,
 	candlestickRepository candlestick.Repository,
-	paramsRepository params.Repository,
+	paramsRegistry params.Registry,
 	adviceRepository advice.Repository,
 	adviser advice.Adviser,
 ) ParamsTesterApp {
@@ -56,17 +58,27 @@ func newTesterApp(
 		tester:           params.NewAdviserParamsTester(candlestickRepository),
 		adviser:          adviser,
 		quoteRepository:  quoteRepository,
-		paramsRepository: paramsRepository,
+		paramsRegistry:   paramsRegistry,
 		adviceRepository: adviceRepository,
 		adviceSelector:   advice.NewDefaultSelector(),
 	}
 }
 
-func (r testerApp) TestParams(ctx context.Context, name string, from, to time.Time) error {
-	p, err := r.paramsRepository.LoadParams(name)
+func (r testerApp) TestParams(ctx context.Context, name string, version int, from, to time.Time) error {
+	var v *params.Version
+	var err error
+	if version == 0 {
+		v, err = r.paramsRegistry.GetLive(name, r.adviser.ParamsSchema())
+	} else {
+		v, err = r.paramsRegistry.GetVersion(name, version, r.adviser.ParamsSchema())
+	}
 	if err != nil {
 		return err
 	}
+	if v == nil {
+		return errors.Errorf("no params of %s promoted", name)
+	}
+	p := v.Document.Params
 
 	quotes, err := r.quoteRepository.GetQuotes(ctx)
 	if err != nil {
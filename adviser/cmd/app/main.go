@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/websmee/ms/pkg/cmd"
 	"github.com/websmee/ms/pkg/errors"
@@ -17,6 +19,8 @@ import (
 	healthProto "github.com/websmee/ms/pkg/discovery/health/proto"
 
 	"github.com/websmee/example_of_my_code/adviser/cmd/dependencies"
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
 	"github.com/websmee/example_of_my_code/adviser/infrastructure"
 	grpcInfra "github.com/websmee/example_of_my_code/adviser/infrastructure/grpc"
 
@@ -36,6 +40,9 @@ import (
 	"github.com/websmee/example_of_my_code/adviser/app"
 )
 
+// resubscribeDelay keeps a broken quotes connection from being hammered by the candlestick subscription
+const resubscribeDelay = 10 * time.Second
+
 func main() {
 	if err := run(); err != nil {
 		os.Exit(1)
@@ -45,19 +52,29 @@ func main() {
 func run() error {
 	fs := flag.NewFlagSet("adviser", flag.ExitOnError)
 	var (
-		paramsPath        = fs.String("params.path", "./files/current/", "path to get params")
-		debugAddr         = fs.String("debug.addr", "0.0.0.0", "Debug and metrics listen address")
-		debugPort         = fs.String("debug.port", "8080", "Debug and metrics listen port")
-		grpcAddr          = fs.String("grpc.addr", "0.0.0.0", "gRPC listen address")
-		grpcPort          = fs.String("grpc.port", "8082", "gRPC listen port")
-		quotesAddr        = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
-		consulAddr        = fs.String("consul.addr", "127.0.0.1", "consul address")
-		consulPort        = fs.String("consul.port", "8500", "consul port")
-		consulServiceName = fs.String("consul.service_name", "adviser", "consul service name")
-		consulServiceAddr = fs.String("consul.service_addr", "127.0.0.1", "consul service addr")
-		consulServicePort = fs.String("consul.service_port", "8082", "consul service port")
-		zipkinURL         = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
-		zipkinBridge      = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
+		advisers           = fs.String("advisers", "CBS_SCALED", "comma separated types of the advisers to run: CBS, CBS_SCALED, FT")
+		cbsParams          = fs.String("cbs.params_name", "CBS", "params of the CBS adviser")
+		cbsSource          = fs.String("cbs.params_source", "", "where the params of the CBS adviser are watched, params.source if empty")
+		cbsScaledParams    = fs.String("cbs_scaled.params_name", "CBS_SCALED", "params of the CBS_SCALED adviser")
+		cbsScaledSource    = fs.String("cbs_scaled.params_source", "", "where the params of the CBS_SCALED adviser are watched, params.source if empty")
+		ftParams           = fs.String("ft.params_name", "FT", "params of the FT adviser")
+		ftSource           = fs.String("ft.params_source", "", "where the params of the FT adviser are watched, params.source if empty")
+		paramsSource       = fs.String("params.source", "registry", "where the live params are watched: registry or consul")
+		registryPath       = fs.String("registry.path", "./files/registry/", "path of the params registry to get the promoted params from")
+		registryPoll       = fs.Duration("registry.poll_interval", 10*time.Second, "how often the params registry is checked for promotions")
+		consulParamsPrefix = fs.String("consul.params_prefix", "adviser/params/", "consul KV prefix of the live params, followed by their name")
+		debugAddr          = fs.String("debug.addr", "0.0.0.0", "Debug and metrics listen address")
+		debugPort          = fs.String("debug.port", "8080", "Debug and metrics listen port")
+		grpcAddr           = fs.String("grpc.addr", "0.0.0.0", "gRPC listen address")
+		grpcPort           = fs.String("grpc.port", "8082", "gRPC listen port")
+		quotesAddr         = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
+		consulAddr         = fs.String("consul.addr", "127.0.0.1", "consul address")
+		consulPort         = fs.String("consul.port", "8500", "consul port")
+		consulServiceName  = fs.String("consul.service_name", "adviser", "consul service name")
+		consulServiceAddr  = fs.String("consul.service_addr", "127.0.0.1", "consul service addr")
+		consulServicePort  = fs.String("consul.service_port", "8082", "consul service port")
+		zipkinURL          = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
+		zipkinBridge       = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
 	)
 	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags]")
 	_ = fs.Parse(os.Args[1:])
@@ -65,12 +82,13 @@ func run() error {
 	// DEPENDENCIES
 
 	var (
-		err          error
-		logger       log.Logger
-		zipkinTracer *zipkin.Tracer
-		tracer       stdopentracing.Tracer
-		quotesConn   *grpc.ClientConn
-		onclose      func()
+		err            error
+		logger         log.Logger
+		zipkinTracer   *zipkin.Tracer
+		tracer         stdopentracing.Tracer
+		quotesConn     *grpc.ClientConn
+		adviserConfigs []app.AdviserConfig
+		onclose        func()
 	)
 	{
 		logger = dependencies.GetLogger()
@@ -85,6 +103,23 @@ func run() error {
 			_ = logger.Log("dependencies", "quotesConn", "error", err, "stack", errors.GetStackTrace(err))
 		}
 		defer quotesConn.Close()
+
+		adviserConfigs, err = dependencies.GetAdviserConfigs(
+			*advisers,
+			map[advice.AdviserType]dependencies.AdviserParams{
+				advice.AdviserTypeCBS:       {Name: *cbsParams, Source: *cbsSource},
+				advice.AdviserTypeCBSScaled: {Name: *cbsScaledParams, Source: *cbsScaledSource},
+				advice.AdviserTypeFT:        {Name: *ftParams, Source: *ftSource},
+			},
+			*paramsSource,
+			func(source string) (params.Watcher, error) {
+				return dependencies.GetParamsWatcher(logger, source, *registryPath, *registryPoll, *consulParamsPrefix, *consulAddr, *consulPort)
+			},
+		)
+		if err != nil {
+			_ = logger.Log("dependencies", "advisers", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
 	}
 
 	// METRICS
@@ -99,6 +134,15 @@ func run() error {
 			Help:      "Total count of candlesticks requested.",
 		}, []string{})
 	}
+	var paramsChanges metrics.Counter
+	{
+		paramsChanges = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "fintech",
+			Subsystem: "adviser",
+			Name:      "params_changes",
+			Help:      "Total count of live params applied or rejected.",
+		}, []string{"adviser_type", "status"})
+	}
 	var duration metrics.Histogram
 	{
 		// Endpoint-level metrics.
@@ -121,10 +165,10 @@ func run() error {
 		)
 		candlestickRepository = infrastructure.NewCandlestickGRPCRepository(quotesApp)
 		quoteRepository       = infrastructure.NewQuoteGRPCRepository(quotesApp)
-		paramsRepository      = infrastructure.NewParamsFileRepository(*paramsPath)
-		adviser               = app.NewAdviserApp(logger, count, quoteRepository, candlestickRepository, paramsRepository)
+		adviser               = app.NewAdviserApp(logger, count, quoteRepository, candlestickRepository, quotesApp, adviserConfigs, paramsChanges)
 		endpoints             = api.NewAdviser(adviser, logger, duration, tracer, zipkinTracer)
 		grpcServer            = api.NewGRPCServer(endpoints, tracer, zipkinTracer, logger)
+		grpcServerV2          = api.NewGRPCServerV2(endpoints, adviser, tracer, zipkinTracer, logger)
 
 		healthCheckEndpoint = health.NewCheckEndpoint(func(service string) health.CheckStatus {
 			if adviser.HealthCheck() {
@@ -174,6 +218,7 @@ func run() error {
 			_ = logger.Log("transport", "gRPC", "addr", addr)
 			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 			proto.RegisterAdviserServer(baseServer, grpcServer)
+			proto.RegisterAdviserV2Server(baseServer, grpcServerV2)
 			healthProto.RegisterHealthServer(baseServer, grpcHealthServer)
 			return baseServer.Serve(grpcListener)
 		}, func(error) {
@@ -181,6 +226,35 @@ func run() error {
 			serviceRegistrar.DeregisterAll()
 		})
 	}
+	{
+		// WATCH LIVE PARAMS
+
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return adviser.WatchParams(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+	{
+		// ADVISE ON CLOSED CANDLESTICKS
+
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			for {
+				_ = adviser.WatchCandlesticks(ctx) // the app logs the error
+
+				// the bars closed meanwhile are computed by the next GetAdvices
+				select {
+				case <-time.After(resubscribeDelay):
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		}, func(error) {
+			cancel()
+		})
+	}
 	{
 		// This function just sits and waits for ctrl-C.
 		cancelInterrupt := make(chan struct{})
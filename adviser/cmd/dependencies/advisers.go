@@ -0,0 +1,45 @@
+package dependencies
+
+import (
+	"github.com/websmee/example_of_my_code/adviser/app"
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+// AdviserParams are the name of the params of an adviser and where they are watched, the default source if empty.
+type AdviserParams struct {
+	Name   string
+	Source string
+}
+
+// GetAdviserConfigs enables the advisers of the comma separated types, the ones watching the same source share its watcher.
+func GetAdviserConfigs(
+	advisers string,
+	adviserParams map[advice.AdviserType]AdviserParams,
+	defaultSource string,
+	getWatcher func(source string) (params.Watcher, error),
+) ([]app.AdviserConfig, error) {
+	types, err := advice.ParseAdviserTypes(advisers)
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []app.AdviserConfig
+	watchers := make(map[string]params.Watcher)
+	for _, t := range types {
+		p := adviserParams[t]
+		if p.Source == "" {
+			p.Source = defaultSource
+		}
+		if watchers[p.Source] == nil {
+			watchers[p.Source], err = getWatcher(p.Source)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		configs = append(configs, app.AdviserConfig{Type: t, ParamsName: p.Name, ParamsWatcher: watchers[p.Source]})
+	}
+
+	return configs, nil
+}
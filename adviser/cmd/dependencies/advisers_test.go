@@ -0,0 +1,70 @@
+package dependencies
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+type namedWatcher struct {
+	source string
+}
+
+func (r *namedWatcher) Watch(context.Context, string, func(v *params.Version, err error)) error {
+	return nil
+}
+
+func TestGetAdviserConfigs(t *testing.T) {
+	var created []string
+	getWatcher := func(source string) (params.Watcher, error) {
+		if source == "broken" {
+			return nil, errors.New("no such source")
+		}
+		created = append(created, source)
+		return &namedWatcher{source}, nil
+	}
+	adviserParams := map[advice.AdviserType]AdviserParams{
+		advice.AdviserTypeCBS:       {Name: "CBS"},
+		advice.AdviserTypeCBSScaled: {Name: "CBS_SCALED_V2", Source: "consul"},
+		advice.AdviserTypeFT:        {Name: "FT"},
+	}
+
+	configs, err := GetAdviserConfigs("CBS_SCALED,CBS,FT", adviserParams, "registry", getWatcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configs) != 3 {
+		t.Fatalf("expected 3 advisers, got %d", len(configs))
+	}
+	expected := []struct {
+		t      advice.AdviserType
+		name   string
+		source string
+	}{
+		{advice.AdviserTypeCBSScaled, "CBS_SCALED_V2", "consul"},
+		{advice.AdviserTypeCBS, "CBS", "registry"},
+		{advice.AdviserTypeFT, "FT", "registry"},
+	}
+	for i, e := range expected {
+		c := configs[i]
+		if c.Type != e.t || c.ParamsName != e.name || c.ParamsWatcher.(*namedWatcher).source != e.source {
+			t.Errorf("expected %s by %s from %s, got %s by %s from %s",
+				e.t, e.name, e.source, c.Type, c.ParamsName, c.ParamsWatcher.(*namedWatcher).source)
+		}
+	}
+	// the advisers of a source share its watcher
+	if configs[1].ParamsWatcher != configs[2].ParamsWatcher || len(created) != 2 {
+		t.Errorf("expected a watcher per source, created %v", created)
+	}
+
+	if _, err := GetAdviserConfigs("CBS,UNKNOWN", adviserParams, "registry", getWatcher); err == nil {
+		t.Error("expected an error for an unknown adviser type")
+	}
+	if _, err := GetAdviserConfigs("CBS", adviserParams, "broken", getWatcher); err == nil {
+		t.Error("expected an error for a watcher that can't be created")
+	}
+}
@@ -0,0 +1,29 @@
+package dependencies
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/hashicorp/consul/api"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+	"github.com/websmee/example_of_my_code/adviser/infrastructure"
+)
+
+func GetParamsWatcher(logger log.Logger, source, registryPath string, pollInterval time.Duration, consulPrefix, consulAddr, consulPort string) (params.Watcher, error) {
+	switch source {
+	case "registry":
+		return infrastructure.NewParamsFileWatcher(registryPath, pollInterval), nil
+	case "consul":
+		consulConfig := api.DefaultConfig()
+		consulConfig.Address = "http://" + consulAddr + ":" + consulPort
+		consulClient, err := api.NewClient(consulConfig)
+		if err != nil {
+			return nil, err
+		}
+		return infrastructure.NewParamsConsulWatcher(logger, consulClient, consulPrefix), nil
+	default:
+		return nil, errors.Errorf("unknown params source %q", source)
+	}
+}
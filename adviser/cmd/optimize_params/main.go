@@ -31,17 +31,18 @@ func main() {
 func run() error {
 	fs := flag.NewFlagSet("optimize_params", flag.ExitOnError)
 	var (
-		paramsName   = fs.String("params.name", "CBS", "name of the params")
-		paramsPath   = fs.String("params.path", "./files/params/", "path to get/save params")
-		periodFrom   = fs.String("optimizer.periodFrom", "2021-01-01T00:00:00Z", "optimizing params for this period")
-		periodTo     = fs.String("optimizer.periodTo", "2021-04-01T00:00:00Z", "optimizing params for this period")
-		modifyRate   = fs.Float64("optimizer.modifyRate", 1, "params change rate (bigger means faster but less detailed)")
-		minFrequency = fs.Float64("optimizer.minFrequency", 3, "minimal advice frequency (%) required for the period")
-		quotesAddr   = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
-		consulAddr   = fs.String("consul.addr", "127.0.0.1", "consul address")
-		consulPort   = fs.String("consul.port", "8500", "consul port")
-		zipkinURL    = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
-		zipkinBridge = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
+		paramsName     = fs.String("params.name", "CBS_SCALED", "name of the params")
+		registryPath   = fs.String("registry.path", "./files/registry/", "path of the params registry to add the optimized params to")
+		periodFrom     = fs.String("optimizer.periodFrom", "2021-01-01T00:00:00Z", "optimizing params for this period")
+		periodTo       = fs.String("optimizer.periodTo", "2021-04-01T00:00:00Z", "optimizing params for this period")
+		modifyRate     = fs.Float64("optimizer.modifyRate", 1, "params change rate (bigger means faster but less detailed)")
+		minFrequency   = fs.Float64("optimizer.minFrequency", 3, "minimal advice frequency (%) required for the period")
+		cacheIntervals = fs.String("cache.intervals", "1h", "comma-separated candlestick intervals to cache: 1m,5m,15m,1h,4h,1d,1w")
+		quotesAddr     = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
+		consulAddr     = fs.String("consul.addr", "127.0.0.1", "consul address")
+		consulPort     = fs.String("consul.port", "8500", "consul port")
+		zipkinURL      = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
+		zipkinBridge   = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
 	)
 	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags]")
 	_ = fs.Parse(os.Args[1:])
@@ -81,6 +82,12 @@ func run() error {
 	from, _ := time.Parse(time.RFC3339, *periodFrom)
 	to, _ := time.Parse(time.RFC3339, *periodTo)
 
+	intervals, err := candlestick.ParseIntervals(*cacheIntervals)
+	if err != nil {
+		_ = logger.Log("init", "intervals", "error", err, "stack", errors.GetStackTrace(err))
+		return err
+	}
+
 	var optimizerApp app.ParamsOptimizerApp
 	{
 		quotesApp := grpcInfra.NewQuotesAppGRPCClient(
@@ -94,7 +101,7 @@ func run() error {
 			ctx,
 			quoteRepository,
 			infrastructure.NewCandlestickGRPCRepository(quotesApp),
-			[]candlestick.Interval{candlestick.IntervalHour},
+			intervals,
 			from,
 			to,
 		)
@@ -102,8 +109,8 @@ func run() error {
 			_ = logger.Log("init", "candlestickCacheRepository", "error", err, "stack", errors.GetStackTrace(err))
 			return err
 		}
-		paramsRepository := infrastructure.NewParamsFileRepository(*paramsPath)
-		optimizerApp = app.NewCBSScaledOptimizerApp(quoteRepository, candlestickCacheRepository, paramsRepository, *modifyRate)
+		paramsRegistry := infrastructure.NewParamsFileRegistry(*registryPath)
+		optimizerApp = app.NewCBSScaledOptimizerApp(quoteRepository, candlestickCacheRepository, paramsRegistry, *modifyRate)
 	}
 
 	// RUN
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/websmee/ms/pkg/cmd"
+
+	"github.com/websmee/example_of_my_code/adviser/app"
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+	"github.com/websmee/example_of_my_code/adviser/infrastructure"
+)
+
+const usage = `commands:
+  list NAME
+  history NAME
+  import NAME FILE
+  export [-format yaml|json] NAME VERSION DIR
+  promote NAME VERSION
+  rollback NAME`
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("params_registry", flag.ExitOnError)
+	var (
+		registryPath = fs.String("registry.path", "./files/registry/", "path of the params registry")
+		adviserType  = fs.String("adviser", "", "type of the adviser running by the params, the params name if empty")
+	)
+	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags] <command> [args]\n\n"+usage)
+	_ = fs.Parse(os.Args[1:])
+
+	args := fs.Args()
+	if len(args) < 2 {
+		fs.Usage()
+		return errors.New("command and name are required")
+	}
+
+	registry := infrastructure.NewParamsFileRegistry(*registryPath)
+	if args[0] == "export" {
+		return exportParams(registry, *adviserType, args[1:])
+	}
+
+	command, name, args := args[0], args[1], args[2:]
+	t, schema, err := schemaOf(*adviserType, name)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "list":
+		versions, err := registry.ListVersions(name)
+		if err != nil {
+			return err
+		}
+		for i := range versions {
+			printVersion(versions[i])
+		}
+	case "history":
+		promotions, err := registry.GetPromotions(name)
+		if err != nil {
+			return err
+		}
+		for _, p := range promotions {
+			fmt.Printf("%d\t%t\t%s\n", p.Version, p.Rollback, p.PromotedAt.Format("2006-01-02T15:04:05Z07:00"))
+		}
+	case "import":
+		return importParams(registry, name, t, schema, args)
+	case "promote":
+		if len(args) < 1 {
+			return errors.New("promote requires NAME and VERSION")
+		}
+		number, err := strconv.Atoi(args[0])
+		if err != nil {
+			return errors.Wrap(err, "invalid version")
+		}
+		// the params the service can't run by are never promoted
+		v, err := registry.GetVersion(name, number, schema)
+		if err != nil {
+			return err
+		}
+		if v.Document.AdviserType != "" && v.Document.AdviserType != t {
+			return errors.Errorf("%s %d is of the adviser %s, not %s", name, number, v.Document.AdviserType, t)
+		}
+		p, err := registry.Promote(name, number)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %d is live\n", name, p.Version)
+	case "rollback":
+		p, err := registry.Rollback(name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %d is live\n", name, p.Version)
+	default:
+		return errors.Errorf("unknown command %q", command)
+	}
+
+	return nil
+}
+
+// importParams adds the params file of any format the file repository reads, the legacy CSV too
+func importParams(registry params.Registry, name string, t advice.AdviserType, schema advice.ParamsSchema, args []string) error {
+	if len(args) < 1 {
+		return errors.New("import requires NAME and FILE")
+	}
+	file := args[0]
+	dir := filepath.Dir(file) + string(filepath.Separator)
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+
+	doc, err := infrastructure.NewParamsFileRepository(dir, infrastructure.ParamsFormatYAML).LoadParams(base, schema)
+	if err != nil {
+		return err
+	}
+	if doc.AdviserType == "" {
+		doc.AdviserType = t
+	}
+
+	v, err := registry.AddVersion(name, *doc)
+	if err != nil {
+		return err
+	}
+	printVersion(*v)
+
+	return nil
+}
+
+func exportParams(registry params.Registry, adviserType string, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", "yaml", "yaml or json")
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 3 {
+		return errors.New("export requires NAME, VERSION and DIR")
+	}
+	name := fs.Arg(0)
+	_, schema, err := schemaOf(adviserType, name)
+	if err != nil {
+		return err
+	}
+	number, err := strconv.Atoi(fs.Arg(1))
+	if err != nil {
+		return errors.Wrap(err, "invalid version")
+	}
+	f, err := infrastructure.ParseParamsFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	v, err := registry.GetVersion(name, number, schema)
+	if err != nil {
+		return err
+	}
+
+	dir := fs.Arg(2) + string(filepath.Separator)
+	return infrastructure.NewParamsFileRepository(dir, f).SaveParams(fmt.Sprintf("%s_v%d", name, number), v.Document)
+}
+
+func schemaOf(adviserType, name string) (advice.AdviserType, advice.ParamsSchema, error) {
+	if adviserType == "" {
+		adviserType = name
+	}
+	t, err := advice.ParseAdviserType(adviserType)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "no adviser of params %s, set -adviser", name)
+	}
+
+	schema, err := app.GetParamsSchema(t)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return t, schema, nil
+}
+
+func printVersion(v params.Version) {
+	fmt.Printf(
+		"%d\t%d\t%s\t%.2f\t%.2f\t%s\n",
+		v.Number, v.Parent, v.AddedAt.Format("2006-01-02T15:04:05Z07:00"),
+		v.Document.Scores[params.ScoreFrequency], v.Document.Scores[params.ScoreAccuracy], v.Document.Params,
+	)
+}
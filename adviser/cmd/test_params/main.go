@@ -32,16 +32,18 @@ func main() {
 func run() error {
 	fs := flag.NewFlagSet("test_params", flag.ExitOnError)
 	var (
-		paramsName   = fs.String("params.name", "CBS_test", "name of the params")
-		paramsPath   = fs.String("params.path", "./files/params/", "path to get params")
-		advicesPath  = fs.String("advices.path", "./files/advices/", "path to save results")
-		periodFrom   = fs.String("tester.periodFrom", "2021-01-01T00:00:00Z", "testing params for this period")
-		periodTo     = fs.String("tester.periodTo", "2021-04-01T00:00:00Z", "testing params for this period")
-		quotesAddr   = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
-		consulAddr   = fs.String("consul.addr", "127.0.0.1", "consul address")
-		consulPort   = fs.String("consul.port", "8500", "consul port")
-		zipkinURL    = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
-		zipkinBridge = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
+		paramsName     = fs.String("params.name", "CBS_SCALED", "name of the params")
+		paramsVersion  = fs.Int("params.version", 0, "version of the params to test, the promoted one if 0")
+		registryPath   = fs.String("registry.path", "./files/registry/", "path of the params registry")
+		advicesPath    = fs.String("advices.path", "./files/advices/", "path to save results")
+		periodFrom     = fs.String("tester.periodFrom", "2021-01-01T00:00:00Z", "testing params for this period")
+		periodTo       = fs.String("tester.periodTo", "2021-04-01T00:00:00Z", "testing params for this period")
+		cacheIntervals = fs.String("cache.intervals", "1h", "comma-separated candlestick intervals to cache: 1m,5m,15m,1h,4h,1d,1w")
+		quotesAddr     = fs.String("quotes.addr", "", "use this addr instead of consul discovery")
+		consulAddr     = fs.String("consul.addr", "127.0.0.1", "consul address")
+		consulPort     = fs.String("consul.port", "8500", "consul port")
+		zipkinURL      = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
+		zipkinBridge   = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
 	)
 	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags]")
 	_ = fs.Parse(os.Args[1:])
@@ -81,6 +83,12 @@ func run() error {
 	from, _ := time.Parse(time.RFC3339, *periodFrom)
 	to, _ := time.Parse(time.RFC3339, *periodTo)
 
+	intervals, err := candlestick.ParseIntervals(*cacheIntervals)
+	if err != nil {
+		_ = logger.Log("init", "intervals", "error", err, "stack", errors.GetStackTrace(err))
+		return err
+	}
+
 	var testerApp app.ParamsTesterApp
 	{
 		quotesApp := grpcInfra.NewQuotesAppGRPCClient(
@@ -94,7 +102,7 @@ func run() error {
 			ctx,
 			quoteRepository,
 			infrastructure.NewCandlestickGRPCRepository(quotesApp),
-			[]candlestick.Interval{candlestick.IntervalHour},
+			intervals,
 			from.Add(-params.TestOrderExpirationPeriod), // add extra period for current advice history
 			to.Add(params.TestOrderExpirationPeriod),    // add extra period for expiration
 		)
@@ -105,7 +113,7 @@ func run() error {
 		testerApp = app.NewCBSScaledTesterApp(
 			quoteRepository,
 			candlestickCacheRepository,
-			infrastructure.NewParamsFileRepository(*paramsPath),
+			infrastructure.NewParamsFileRegistry(*registryPath),
 			infrastructure.NewAdviceFileRepository(*advicesPath),
 		)
 	}
@@ -119,7 +127,7 @@ func run() error {
 		cancelFunc()
 	}()
 
-	if err := testerApp.TestParams(ctx, *paramsName, from, to); err != nil {
+	if err := testerApp.TestParams(ctx, *paramsName, *paramsVersion, from, to); err != nil {
 		_ = logger.Log("run", "testerApp", "error", err, "stack", errors.GetStackTrace(err))
 		return err
 	}
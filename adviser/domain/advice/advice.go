@@ -17,8 +17,13 @@ const (
 	StatusOK Status = "ok"
 )
 
+// Advice is an order of one unit of the quote. Amount is the margin it takes with the leverage,
+// TakeProfitAmount and StopLossAmount are the profit and the loss when it's closed by those prices.
+// Only the advices of StatusOK have the prices and the amounts of the order set.
 type Advice struct {
 	Quote            quote.Quote
+	AdviserType      AdviserType
+	Status           Status
 	Candlesticks     []candlestick.Candlestick
 	Price            decimal.Decimal
 	Amount           decimal.Decimal
@@ -28,6 +33,48 @@ type Advice struct {
 	StopLossAmount   decimal.Decimal
 	Leverage         int
 	ExpiresAt        time.Time
+	// ParamsVersion is the version of the adviser params in the registry
+	ParamsVersion int
+}
+
+// NewAdvice completes the internal advice with the candlesticks it was given by and the time its order expires.
+func NewAdvice(
+	internal InternalAdvice,
+	q quote.Quote,
+	adviserType AdviserType,
+	candlesticks []candlestick.Candlestick,
+	expiresAt time.Time,
+) Advice {
+	leverage := internal.Leverage
+	if leverage <= 0 {
+		leverage = DefaultLeverage
+	}
+
+	a := Advice{
+		Quote:            q,
+		AdviserType:      adviserType,
+		Status:           internal.Status,
+		Candlesticks:     candlesticks,
+		Price:            internal.CurrentPrice,
+		Amount:           decimal.Zero,
+		TakeProfitPrice:  decimal.Zero,
+		TakeProfitAmount: decimal.Zero,
+		StopLossPrice:    decimal.Zero,
+		StopLossAmount:   decimal.Zero,
+		Leverage:         leverage,
+		ExpiresAt:        expiresAt,
+	}
+	if internal.Status != StatusOK {
+		return a
+	}
+
+	a.Amount = internal.CurrentPrice.Div(decimal.NewFromInt(int64(leverage)))
+	a.TakeProfitPrice = internal.TakeProfit
+	a.TakeProfitAmount = internal.TakeProfit.Sub(internal.CurrentPrice).Abs()
+	a.StopLossPrice = internal.StopLoss
+	a.StopLossAmount = internal.StopLoss.Sub(internal.CurrentPrice).Abs()
+
+	return a
 }
 
 type InternalAdvice struct {
@@ -43,5 +90,5 @@ type InternalAdvice struct {
 	OrderResult   candlestick.OrderResult
 	OrderClosed   time.Time
 	AdviserType   AdviserType
-	AdviserParams []decimal.Decimal
+	AdviserParams Params
 }
@@ -0,0 +1,50 @@
+package advice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/quote"
+)
+
+func TestNewAdvice(t *testing.T) {
+	expiresAt := time.Date(2021, 3, 1, 16, 0, 0, 0, time.UTC)
+	a := NewAdvice(InternalAdvice{
+		Status:       StatusOK,
+		CurrentPrice: decimal.NewFromInt(100),
+		TakeProfit:   decimal.NewFromInt(90),
+		StopLoss:     decimal.NewFromInt(104),
+		Leverage:     4,
+	}, quote.Quote{Symbol: "AAPL"}, AdviserTypeCBS, nil, expiresAt)
+
+	if !a.Amount.Equal(decimal.NewFromInt(25)) {
+		t.Error("amount", a.Amount)
+	}
+	if !a.TakeProfitPrice.Equal(decimal.NewFromInt(90)) || !a.TakeProfitAmount.Equal(decimal.NewFromInt(10)) {
+		t.Error("take profit", a.TakeProfitPrice, a.TakeProfitAmount)
+	}
+	if !a.StopLossPrice.Equal(decimal.NewFromInt(104)) || !a.StopLossAmount.Equal(decimal.NewFromInt(4)) {
+		t.Error("stop loss", a.StopLossPrice, a.StopLossAmount)
+	}
+	if a.Leverage != 4 || !a.ExpiresAt.Equal(expiresAt) {
+		t.Error("leverage", a.Leverage, "expires at", a.ExpiresAt)
+	}
+}
+
+func TestNewAdvice_Diagnostic(t *testing.T) {
+	a := NewAdvice(InternalAdvice{
+		Status:       StatusCBSStormTooWeak,
+		CurrentPrice: decimal.NewFromInt(100),
+		TakeProfit:   decimal.NewFromInt(90),
+		StopLoss:     decimal.NewFromInt(104),
+	}, quote.Quote{Symbol: "AAPL"}, AdviserTypeCBS, nil, time.Time{})
+
+	if !a.Amount.IsZero() || !a.TakeProfitPrice.IsZero() || !a.StopLossAmount.IsZero() {
+		t.Error("order set", a.Amount, a.TakeProfitPrice, a.StopLossAmount)
+	}
+	if a.Leverage != DefaultLeverage {
+		t.Error("leverage", a.Leverage)
+	}
+}
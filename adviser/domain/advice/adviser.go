@@ -2,8 +2,9 @@ package advice
 
 import (
 	"context"
+	"strings"
 
-	"github.com/shopspring/decimal"
+	"github.com/pkg/errors"
 
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
 )
@@ -11,10 +12,45 @@ import (
 type AdviserType string
 
 const (
-	AdviserTypeCBS AdviserType = "CBS"
-	AdviserTypeFT  AdviserType = "FT"
+	AdviserTypeCBS       AdviserType = "CBS"
+	AdviserTypeCBSScaled AdviserType = "CBS_SCALED"
+	AdviserTypeFT        AdviserType = "FT"
 )
 
+// AdviserTypes lists every adviser there is.
+var AdviserTypes = []AdviserType{AdviserTypeCBS, AdviserTypeCBSScaled, AdviserTypeFT}
+
+func ParseAdviserType(s string) (AdviserType, error) {
+	for _, t := range AdviserTypes {
+		if string(t) == s {
+			return t, nil
+		}
+	}
+
+	return "", errors.Errorf("unknown adviser type %q", s)
+}
+
+// ParseAdviserTypes parses the comma separated types, every type is listed once.
+func ParseAdviserTypes(s string) ([]AdviserType, error) {
+	var types []AdviserType
+	listed := make(map[AdviserType]bool)
+	for _, part := range strings.Split(s, ",") {
+		t, err := ParseAdviserType(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		if listed[t] {
+			return nil, errors.Errorf("adviser type %s listed twice", t)
+		}
+		listed[t] = true
+		types = append(types, t)
+	}
+
+	return types, nil
+}
+
 type Adviser interface {
-	GetAdvices(ctx context.Context, adviserParams []decimal.Decimal, current candlestick.Candlestick, quoteSymbol string) ([]InternalAdvice, error)
+	// GetAdvices expects the params validated by the ParamsSchema.
+	GetAdvices(ctx context.Context, adviserParams Params, current candlestick.Candlestick, quoteSymbol string) ([]InternalAdvice, error)
+	ParamsSchema() ParamsSchema
 }
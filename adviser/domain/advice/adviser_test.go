@@ -0,0 +1,39 @@
+package advice
+
+import "testing"
+
+func TestParseAdviserType(t *testing.T) {
+	for _, expected := range AdviserTypes {
+		if got, err := ParseAdviserType(string(expected)); err != nil || got != expected {
+			t.Errorf("expected %s, got %s %v", expected, got, err)
+		}
+	}
+
+	for _, s := range []string{"", "cbs", "CBS "} {
+		if _, err := ParseAdviserType(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestParseAdviserTypes(t *testing.T) {
+	types, err := ParseAdviserTypes(" CBS_SCALED, FT,CBS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []AdviserType{AdviserTypeCBSScaled, AdviserTypeFT, AdviserTypeCBS}
+	if len(types) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, types)
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, types)
+		}
+	}
+
+	for _, s := range []string{"", "CBS,", "CBS,XYZ", "FT,CBS,FT"} {
+		if _, err := ParseAdviserTypes(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
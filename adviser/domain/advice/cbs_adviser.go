@@ -33,15 +33,28 @@ func NewCBSAdviser(repository candlestick.Repository, calc candlestick.Calculato
 	}
 }
 
+func (r cbsAdviser) ParamsSchema() ParamsSchema {
+	return CBSParamsSchema
+}
+
 func (r cbsAdviser) GetAdvices(
 	ctx context.Context,
-	adviserParams []decimal.Decimal,
+	adviserParams Params,
 	current candlestick.Candlestick,
 	quoteSymbol string,
 ) ([]InternalAdvice, error) {
 	cbsParams := new(CBSParams)
 	cbsParams.SetParams(adviserParams)
 
+	return r.advise(ctx, cbsParams, current, quoteSymbol)
+}
+
+func (r cbsAdviser) advise(
+	ctx context.Context,
+	cbsParams *CBSParams,
+	current candlestick.Candlestick,
+	quoteSymbol string,
+) ([]InternalAdvice, error) {
 	advices := []InternalAdvice{{
 		Status:        StatusOK,
 		QuoteSymbol:   quoteSymbol,
@@ -54,7 +67,7 @@ func (r cbsAdviser) GetAdvices(
 		Leverage:      DefaultLeverage,
 		OrderResult:   candlestick.OrderResultNone,
 		AdviserType:   AdviserTypeCBS,
-		AdviserParams: adviserParams,
+		AdviserParams: cbsParams.GetParams(),
 	}}
 
 	status, direction, err := r.checkStorm(ctx, cbsParams, current, quoteSymbol)
@@ -2,6 +2,20 @@ package advice
 
 import "github.com/shopspring/decimal"
 
+var CBSParamsSchema = ParamsSchema{
+	intParam("calm_duration_hours", 1, 1000, 24, "hours of the calm period before the storm"),
+	decimalParam("calm_max_change", 0, 1000000, 1, "max change of the price within the calm period"),
+	decimalParam("calm_max_curvature", 0, 1000000, 0.5, "max difference of the open and the close of the calm period"),
+	intParam("storm_duration_hours", 1, 1000, 8, "hours of the storm period up to the current bar"),
+	notAbove(decimalParam("storm_min_power", 0, 1000000, 3, "min difference of the open and the close of the storm period"), "storm_max_power"),
+	decimalParam("storm_max_power", 0, 1000000, 8, "max difference of the open and the close of the storm period"),
+	decimalParam("storm_min_volume", 0, 1000000000000, 0, "min volume of the storm period"),
+	decimalParam("take_profit_diff", 0, 1000000, 1, "distance of the take profit from the current price"),
+	decimalParam("stop_loss_diff", 0, 1000000, 1, "distance of the stop loss from the current price"),
+	intParam("check_direction_hours", 1, 1000, 24, "hours of the SMA the direction is checked by"),
+	decimalParam("check_direction_diff", 0, 1000000, 0, "max distance of the price from the SMA against the direction"),
+}
+
 type CBSParams struct {
 	CalmDurationHours   int
 	CalmMaxChange       decimal.Decimal
@@ -16,32 +30,32 @@ type CBSParams struct {
 	CheckDirectionDiff  decimal.Decimal
 }
 
-func (r *CBSParams) GetParams() []decimal.Decimal {
-	return []decimal.Decimal{
-		decimal.NewFromInt(int64(r.CalmDurationHours)),
-		r.CalmMaxChange,
-		r.CalmMaxCurvature,
-		decimal.NewFromInt(int64(r.StormDurationHours)),
-		r.StormMinPower,
-		r.StormMaxPower,
-		r.StormMinVolume,
-		r.TakeProfitDiff,
-		r.StopLossDiff,
-		decimal.NewFromInt(int64(r.CheckDirectionHours)),
-		r.CheckDirectionDiff,
+func (r *CBSParams) GetParams() Params {
+	return Params{
+		"calm_duration_hours":   decimal.NewFromInt(int64(r.CalmDurationHours)),
+		"calm_max_change":       r.CalmMaxChange,
+		"calm_max_curvature":    r.CalmMaxCurvature,
+		"storm_duration_hours":  decimal.NewFromInt(int64(r.StormDurationHours)),
+		"storm_min_power":       r.StormMinPower,
+		"storm_max_power":       r.StormMaxPower,
+		"storm_min_volume":      r.StormMinVolume,
+		"take_profit_diff":      r.TakeProfitDiff,
+		"stop_loss_diff":        r.StopLossDiff,
+		"check_direction_hours": decimal.NewFromInt(int64(r.CheckDirectionHours)),
+		"check_direction_diff":  r.CheckDirectionDiff,
 	}
 }
 
-func (r *CBSParams) SetParams(params []decimal.Decimal) {
-	r.CalmDurationHours = int(params[0].IntPart())
-	r.CalmMaxChange = params[1]
-	r.CalmMaxCurvature = params[2]
-	r.StormDurationHours = int(params[3].IntPart())
-	r.StormMinPower = params[4]
-	r.StormMaxPower = params[5]
-	r.StormMinVolume = params[6]
-	r.TakeProfitDiff = params[7]
-	r.StopLossDiff = params[8]
-	r.CheckDirectionHours = int(params[9].IntPart())
-	r.CheckDirectionDiff = params[10]
+func (r *CBSParams) SetParams(params Params) {
+	r.CalmDurationHours = params.Int("calm_duration_hours")
+	r.CalmMaxChange = params.Decimal("calm_max_change")
+	r.CalmMaxCurvature = params.Decimal("calm_max_curvature")
+	r.StormDurationHours = params.Int("storm_duration_hours")
+	r.StormMinPower = params.Decimal("storm_min_power")
+	r.StormMaxPower = params.Decimal("storm_max_power")
+	r.StormMinVolume = params.Decimal("storm_min_volume")
+	r.TakeProfitDiff = params.Decimal("take_profit_diff")
+	r.StopLossDiff = params.Decimal("stop_loss_diff")
+	r.CheckDirectionHours = params.Int("check_direction_hours")
+	r.CheckDirectionDiff = params.Decimal("check_direction_diff")
 }
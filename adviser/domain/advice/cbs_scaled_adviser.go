@@ -23,9 +23,13 @@ func NewCBSScaledAdviser(repository candlestick.Repository, calc candlestick.Cal
 	}
 }
 
+func (r cbsScaledAdviser) ParamsSchema() ParamsSchema {
+	return CBSScaledParamsSchema
+}
+
 func (r cbsScaledAdviser) GetAdvices(
 	ctx context.Context,
-	adviserParams []decimal.Decimal,
+	adviserParams Params,
 	current candlestick.Candlestick,
 	quoteSymbol string,
 ) ([]InternalAdvice, error) {
@@ -64,10 +68,14 @@ func (r cbsScaledAdviser) GetAdvices(
 				CheckDirectionDiff:  stormPower.Div(cbsScaledParams.StormPowerToCheckDirectionDiff),
 			}
 
-			a, err := r.cbsAdviser.GetAdvices(ctx, cbsParams.GetParams(), current, quoteSymbol)
+			a, err := r.cbsAdviser.advise(ctx, &cbsParams, current, quoteSymbol)
 			if err != nil {
 				return nil, err
 			}
+			// the params stay the ones the advice was computed by, the type tells they were scaled
+			for i := range a {
+				a[i].AdviserType = AdviserTypeCBSScaled
+			}
 			calmHours++
 			stormHours--
 
@@ -2,6 +2,23 @@ package advice
 
 import "github.com/shopspring/decimal"
 
+// CBSScaledParamsSchema scales the CBS params of every split of the period into the calm and the storm.
+var CBSScaledParamsSchema = ParamsSchema{
+	notAbove(intParam("period_hours_min", 2, 1000, 30, "min hours of the calm and the storm periods together"), "period_hours_max"),
+	intParam("period_hours_max", 2, 1000, 40, "max hours of the calm and the storm periods together"),
+	notAbove(decimalParam("storm_to_calm_min", 0, 1, 0.2, "min share of the storm in the period"), "storm_to_calm_max"),
+	decimalParam("storm_to_calm_max", 0, 1, 0.4, "max share of the storm in the period"),
+	notAbove(decimalParam("storm_min_power_to_calm_max_change", 0, 1000, 3, "storm min power by the max change of the calm period"), "storm_max_power_to_calm_max_change"),
+	decimalParam("storm_max_power_to_calm_max_change", 0, 1000, 8, "storm max power by the max change of the calm period"),
+	decimalParam("storm_min_volume_to_calm_volume", 0, 1000, 0.5, "storm min volume by the volume of the calm period"),
+	decimalParam("calm_max_change_to_storm_power", 0, 1000, 0.35, "calm max change by the storm power"),
+	decimalParam("calm_max_curvature_to_storm_power", 0, 1000, 0.1, "calm max curvature by the storm power"),
+	decimalParam("take_profit_diff_to_storm_power", 0, 1000, 0.4, "take profit distance by the storm power"),
+	decimalParam("stop_loss_diff_to_storm_power", 0, 1000, 0.4, "stop loss distance by the storm power"),
+	decimalParam("calm_to_check_direction", 0.01, 1000, 0.3, "calm hours by the hours of the SMA the direction is checked by"),
+	decimalParam("storm_power_to_check_direction_diff", 0.01, 1000, 1, "storm power by the max distance of the price from the SMA"),
+}
+
 type CBSScaledParams struct {
 	PeriodHoursMin                 int
 	PeriodHoursMax                 int
@@ -18,36 +35,36 @@ type CBSScaledParams struct {
 	StormPowerToCheckDirectionDiff decimal.Decimal
 }
 
-func (r *CBSScaledParams) GetParams() []decimal.Decimal {
-	return []decimal.Decimal{
-		decimal.NewFromInt(int64(r.PeriodHoursMin)),
-		decimal.NewFromInt(int64(r.PeriodHoursMax)),
-		r.StormToCalmMin,
-		r.StormToCalmMax,
-		r.StormMinPowerToCalmMaxChange,
-		r.StormMaxPowerToCalmMaxChange,
-		r.StormMinVolumeToCalmVolume,
-		r.CalmMaxChangeToStormPower,
-		r.CalmMaxCurvatureToStormPower,
-		r.TakeProfitDiffToStormPower,
-		r.StopLossDiffToStormPower,
-		r.CalmToCheckDirection,
-		r.StormPowerToCheckDirectionDiff,
+func (r *CBSScaledParams) GetParams() Params {
+	return Params{
+		"period_hours_min":                    decimal.NewFromInt(int64(r.PeriodHoursMin)),
+		"period_hours_max":                    decimal.NewFromInt(int64(r.PeriodHoursMax)),
+		"storm_to_calm_min":                   r.StormToCalmMin,
+		"storm_to_calm_max":                   r.StormToCalmMax,
+		"storm_min_power_to_calm_max_change":  r.StormMinPowerToCalmMaxChange,
+		"storm_max_power_to_calm_max_change":  r.StormMaxPowerToCalmMaxChange,
+		"storm_min_volume_to_calm_volume":     r.StormMinVolumeToCalmVolume,
+		"calm_max_change_to_storm_power":      r.CalmMaxChangeToStormPower,
+		"calm_max_curvature_to_storm_power":   r.CalmMaxCurvatureToStormPower,
+		"take_profit_diff_to_storm_power":     r.TakeProfitDiffToStormPower,
+		"stop_loss_diff_to_storm_power":       r.StopLossDiffToStormPower,
+		"calm_to_check_direction":             r.CalmToCheckDirection,
+		"storm_power_to_check_direction_diff": r.StormPowerToCheckDirectionDiff,
 	}
 }
 
-func (r *CBSScaledParams) SetParams(params []decimal.Decimal) {
-	r.PeriodHoursMin = int(params[0].IntPart())
-	r.PeriodHoursMax = int(params[1].IntPart())
-	r.StormToCalmMin = params[2]
-	r.StormToCalmMax = params[3]
-	r.StormMinPowerToCalmMaxChange = params[4]
-	r.StormMaxPowerToCalmMaxChange = params[5]
-	r.StormMinVolumeToCalmVolume = params[6]
-	r.CalmMaxChangeToStormPower = params[7]
-	r.CalmMaxCurvatureToStormPower = params[8]
-	r.TakeProfitDiffToStormPower = params[9]
-	r.StopLossDiffToStormPower = params[10]
-	r.CalmToCheckDirection = params[11]
-	r.StormPowerToCheckDirectionDiff = params[12]
+func (r *CBSScaledParams) SetParams(params Params) {
+	r.PeriodHoursMin = params.Int("period_hours_min")
+	r.PeriodHoursMax = params.Int("period_hours_max")
+	r.StormToCalmMin = params.Decimal("storm_to_calm_min")
+	r.StormToCalmMax = params.Decimal("storm_to_calm_max")
+	r.StormMinPowerToCalmMaxChange = params.Decimal("storm_min_power_to_calm_max_change")
+	r.StormMaxPowerToCalmMaxChange = params.Decimal("storm_max_power_to_calm_max_change")
+	r.StormMinVolumeToCalmVolume = params.Decimal("storm_min_volume_to_calm_volume")
+	r.CalmMaxChangeToStormPower = params.Decimal("calm_max_change_to_storm_power")
+	r.CalmMaxCurvatureToStormPower = params.Decimal("calm_max_curvature_to_storm_power")
+	r.TakeProfitDiffToStormPower = params.Decimal("take_profit_diff_to_storm_power")
+	r.StopLossDiffToStormPower = params.Decimal("stop_loss_diff_to_storm_power")
+	r.CalmToCheckDirection = params.Decimal("calm_to_check_direction")
+	r.StormPowerToCheckDirectionDiff = params.Decimal("storm_power_to_check_direction_diff")
 }
@@ -28,9 +28,13 @@ func NewFTAdviser(repository candlestick.Repository, calc candlestick.Calculator
 	}
 }
 
+func (r ftAdviser) ParamsSchema() ParamsSchema {
+	return FTParamsSchema
+}
+
 func (r ftAdviser) GetAdvices(
 	ctx context.Context,
-	adviserParams []decimal.Decimal,
+	adviserParams Params,
 	current candlestick.Candlestick,
 	quoteSymbol string,
 ) ([]InternalAdvice, error) {
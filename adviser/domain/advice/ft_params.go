@@ -4,6 +4,17 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+var FTParamsSchema = ParamsSchema{
+	intParam("trend_duration_hours", 1, 1000, 21, "hours of the trend up to the current bar"),
+	decimalParam("trend_max_volatility", 0, 1000000, 3, "max volatility of the trend"),
+	notAbove(decimalParam("trend_min_curvature", 0, 1000000, 6, "min difference of the open and the close of the trend"), "trend_max_curvature"),
+	decimalParam("trend_max_curvature", 0, 1000000, 9, "max difference of the open and the close of the trend"),
+	decimalParam("take_profit_diff", 0, 1000000, 4, "distance of the take profit from the current price"),
+	decimalParam("stop_loss_diff", 0, 1000000, 4, "distance of the stop loss from the current price"),
+	intParam("check_direction_hours", 1, 1000, 24, "hours of the SMA the direction is checked by"),
+	decimalParam("check_direction_diff", 0, 1000000, 0, "max distance of the price from the SMA against the direction"),
+}
+
 type FTParams struct {
 	TrendDurationHours  int
 	TrendMaxVolatility  decimal.Decimal
@@ -15,26 +26,26 @@ type FTParams struct {
 	CheckDirectionDiff  decimal.Decimal
 }
 
-func (r *FTParams) GetParams() []decimal.Decimal {
-	return []decimal.Decimal{
-		decimal.NewFromInt(int64(r.TrendDurationHours)),
-		r.TrendMaxVolatility,
-		r.TrendMinCurvature,
-		r.TrendMaxCurvature,
-		r.TakeProfitDiff,
-		r.StopLossDiff,
-		decimal.NewFromInt(int64(r.CheckDirectionHours)),
-		r.CheckDirectionDiff,
+func (r *FTParams) GetParams() Params {
+	return Params{
+		"trend_duration_hours":  decimal.NewFromInt(int64(r.TrendDurationHours)),
+		"trend_max_volatility":  r.TrendMaxVolatility,
+		"trend_min_curvature":   r.TrendMinCurvature,
+		"trend_max_curvature":   r.TrendMaxCurvature,
+		"take_profit_diff":      r.TakeProfitDiff,
+		"stop_loss_diff":        r.StopLossDiff,
+		"check_direction_hours": decimal.NewFromInt(int64(r.CheckDirectionHours)),
+		"check_direction_diff":  r.CheckDirectionDiff,
 	}
 }
 
-func (r *FTParams) SetParams(params []decimal.Decimal) {
-	r.TrendDurationHours = int(params[0].IntPart())
-	r.TrendMaxVolatility = params[1]
-	r.TrendMinCurvature = params[2]
-	r.TrendMaxCurvature = params[3]
-	r.TakeProfitDiff = params[4]
-	r.StopLossDiff = params[5]
-	r.CheckDirectionHours = int(params[6].IntPart())
-	r.CheckDirectionDiff = params[7]
+func (r *FTParams) SetParams(params Params) {
+	r.TrendDurationHours = params.Int("trend_duration_hours")
+	r.TrendMaxVolatility = params.Decimal("trend_max_volatility")
+	r.TrendMinCurvature = params.Decimal("trend_min_curvature")
+	r.TrendMaxCurvature = params.Decimal("trend_max_curvature")
+	r.TakeProfitDiff = params.Decimal("take_profit_diff")
+	r.StopLossDiff = params.Decimal("stop_loss_diff")
+	r.CheckDirectionHours = params.Int("check_direction_hours")
+	r.CheckDirectionDiff = params.Decimal("check_direction_diff")
 }
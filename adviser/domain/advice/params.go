@@ -0,0 +1,198 @@
+package advice
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+type ParamType string
+
+const (
+	ParamTypeInt     ParamType = "int"
+	ParamTypeDecimal ParamType = "decimal"
+)
+
+// ParamSpec describes a param of an adviser, its value is valid within [Min, Max].
+type ParamSpec struct {
+	Name        string
+	Type        ParamType
+	Min         decimal.Decimal
+	Max         decimal.Decimal
+	Default     decimal.Decimal
+	Description string
+	// NotAbove is the name of the param the value can't exceed, the min of a range has the max of it
+	NotAbove string
+}
+
+// ParamsSchema lists the params of an adviser in the order of the legacy positional files.
+type ParamsSchema []ParamSpec
+
+// Params are the values of the params by their names.
+type Params map[string]decimal.Decimal
+
+func (r Params) Int(name string) int {
+	return int(r[name].IntPart())
+}
+
+func (r Params) Decimal(name string) decimal.Decimal {
+	return r[name]
+}
+
+// String lists the params sorted by name.
+func (r Params) String() string {
+	names := r.names()
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + r[name].String()
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// MarshalYAML writes the values as plain numbers, like the params written by hand, decimal.Decimal quotes them.
+func (r Params) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, name := range r.names() {
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: name},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: r[name].String()},
+		)
+	}
+
+	return node, nil
+}
+
+// MarshalJSON writes the values as plain numbers, the same as MarshalYAML.
+func (r Params) MarshalJSON() ([]byte, error) {
+	numbers := make(map[string]json.Number, len(r))
+	for name, v := range r {
+		numbers[name] = json.Number(v.String())
+	}
+
+	return json.Marshal(numbers)
+}
+
+func (r Params) names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (r ParamsSchema) Names() []string {
+	names := make([]string, len(r))
+	for i := range r {
+		names[i] = r[i].Name
+	}
+
+	return names
+}
+
+func (r ParamsSchema) Defaults() Params {
+	p := make(Params, len(r))
+	for i := range r {
+		p[r[i].Name] = r[i].Default
+	}
+
+	return p
+}
+
+// Validate returns the params completed with the defaults of the missing ones.
+func (r ParamsSchema) Validate(p Params) (Params, error) {
+	known := make(map[string]bool, len(r))
+	for i := range r {
+		known[r[i].Name] = true
+	}
+	for name := range p {
+		if !known[name] {
+			return nil, errors.Errorf("unknown param %s", name)
+		}
+	}
+
+	validated := r.Defaults()
+	for i := range r {
+		v, ok := p[r[i].Name]
+		if !ok {
+			continue
+		}
+		if r[i].Type == ParamTypeInt && !v.Equal(v.Truncate(0)) {
+			return nil, errors.Errorf("param %s must be an integer, got %s", r[i].Name, v)
+		}
+		if v.LessThan(r[i].Min) || v.GreaterThan(r[i].Max) {
+			return nil, errors.Errorf("param %s must be within [%s, %s], got %s", r[i].Name, r[i].Min, r[i].Max, v)
+		}
+		validated[r[i].Name] = v
+	}
+
+	for i := range r {
+		if other := r[i].NotAbove; other != "" && validated[r[i].Name].GreaterThan(validated[other]) {
+			return nil, errors.Errorf("param %s must not exceed %s, got %s and %s", r[i].Name, other, validated[r[i].Name], validated[other])
+		}
+	}
+
+	return validated, nil
+}
+
+// FromValues names the values listed in the order of the schema.
+func (r ParamsSchema) FromValues(values []decimal.Decimal) (Params, error) {
+	if len(values) != len(r) {
+		return nil, errors.Errorf("%d params expected, got %d", len(r), len(values))
+	}
+
+	p := make(Params, len(r))
+	for i := range r {
+		p[r[i].Name] = values[i]
+	}
+
+	return p, nil
+}
+
+// Values lists the params in the order of the schema, the missing ones by their defaults.
+func (r ParamsSchema) Values(p Params) []decimal.Decimal {
+	values := make([]decimal.Decimal, len(r))
+	for i := range r {
+		v, ok := p[r[i].Name]
+		if !ok {
+			v = r[i].Default
+		}
+		values[i] = v
+	}
+
+	return values
+}
+
+func intParam(name string, min, max, def int64, description string) ParamSpec {
+	return ParamSpec{
+		Name:        name,
+		Type:        ParamTypeInt,
+		Min:         decimal.NewFromInt(min),
+		Max:         decimal.NewFromInt(max),
+		Default:     decimal.NewFromInt(def),
+		Description: description,
+	}
+}
+
+// notAbove bounds the param by another one of the schema
+func notAbove(spec ParamSpec, other string) ParamSpec {
+	spec.NotAbove = other
+	return spec
+}
+
+func decimalParam(name string, min, max, def float64, description string) ParamSpec {
+	return ParamSpec{
+		Name:        name,
+		Type:        ParamTypeDecimal,
+		Min:         decimal.NewFromFloat(min),
+		Max:         decimal.NewFromFloat(max),
+		Default:     decimal.NewFromFloat(def),
+		Description: description,
+	}
+}
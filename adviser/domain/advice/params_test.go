@@ -0,0 +1,83 @@
+package advice
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+type paramsStruct interface {
+	GetParams() Params
+	SetParams(params Params)
+}
+
+func TestParamsSchema_Structs(t *testing.T) {
+	for name, c := range map[string]struct {
+		schema ParamsSchema
+		params paramsStruct
+	}{
+		"CBS":        {CBSParamsSchema, new(CBSParams)},
+		"CBS scaled": {CBSScaledParamsSchema, new(CBSScaledParams)},
+		"FT":         {FTParamsSchema, new(FTParams)},
+	} {
+		c.params.SetParams(c.schema.Defaults())
+		got := c.params.GetParams()
+		if len(got) != len(c.schema) {
+			t.Error(name, "params", len(got), "schema", len(c.schema))
+		}
+		for _, spec := range c.schema {
+			if !got[spec.Name].Equal(spec.Default) {
+				t.Error(name, spec.Name, got[spec.Name], spec.Default)
+			}
+		}
+	}
+}
+
+func TestParamsSchema_Validate(t *testing.T) {
+	schema := ParamsSchema{
+		intParam("hours", 1, 10, 5, ""),
+		decimalParam("diff", 0, 1, 0.5, ""),
+	}
+
+	p, err := schema.Validate(Params{"diff": decimal.NewFromFloat(0.2)})
+	if err != nil || p.Int("hours") != 5 || !p.Decimal("diff").Equal(decimal.NewFromFloat(0.2)) {
+		t.Error(p, err)
+	}
+
+	for _, invalid := range []Params{
+		{"unknown": decimal.NewFromInt(1)},
+		{"hours": decimal.NewFromFloat(1.5)},
+		{"hours": decimal.NewFromInt(11)},
+		{"diff": decimal.NewFromInt(-1)},
+	} {
+		if _, err := schema.Validate(invalid); err == nil {
+			t.Error("valid", invalid)
+		}
+	}
+
+	// the min of a range can't exceed its max, the missing one by its default either
+	ranged := ParamsSchema{
+		notAbove(intParam("hours_min", 1, 10, 2, ""), "hours_max"),
+		intParam("hours_max", 1, 10, 4, ""),
+	}
+	for _, invalid := range []Params{
+		{"hours_min": decimal.NewFromInt(5), "hours_max": decimal.NewFromInt(3)},
+		{"hours_min": decimal.NewFromInt(5)},
+	} {
+		if _, err := ranged.Validate(invalid); err == nil {
+			t.Error("valid", invalid)
+		}
+	}
+	if _, err := ranged.Validate(Params{"hours_min": decimal.NewFromInt(4)}); err != nil {
+		t.Error(err)
+	}
+	for name, s := range map[string]ParamsSchema{"CBS": CBSParamsSchema, "CBS scaled": CBSScaledParamsSchema, "FT": FTParamsSchema} {
+		if _, err := s.Validate(s.Defaults()); err != nil {
+			t.Error(name, err)
+		}
+	}
+
+	if _, err := schema.FromValues([]decimal.Decimal{decimal.NewFromInt(1)}); err == nil {
+		t.Error("arity")
+	}
+}
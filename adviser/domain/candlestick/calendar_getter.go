@@ -0,0 +1,63 @@
+package candlestick
+
+import (
+	"context"
+	"time"
+
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+type calendarGetter struct {
+	candlestickRepository Repository
+	calendars             calendar.Calendars
+}
+
+// NewCalendarGetter requests exactly the range of count trading bars of the symbol's calendar,
+// instead of guessing it like the greedy getter does.
+func NewCalendarGetter(
+	candlestickRepository Repository,
+	calendars calendar.Calendars,
+) GreedyGetter {
+	return &calendarGetter{
+		candlestickRepository: candlestickRepository,
+		calendars:             calendars,
+	}
+}
+
+func (r calendarGetter) GetCandlesticksByCount(
+	ctx context.Context,
+	symbol string,
+	interval Interval,
+	start time.Time,
+	direction GetterDirection,
+	count int,
+) ([]Candlestick, error) {
+	c := r.calendars.Get(symbol)
+
+	// the range is extended by an interval at the far end, so candlesticks stamped
+	// a bit off the bar start are still found, the extra ones are cut by the count
+	var from, to time.Time
+	switch direction {
+	case GetterDirectionForward:
+		bars := calendar.BarsAfter(c, interval.Duration(), start, count)
+		if len(bars) == 0 {
+			return nil, nil
+		}
+		from, to = start, bars[len(bars)-1].End.Add(interval.Duration())
+	case GetterDirectionBackward:
+		bars := calendar.BarsBefore(c, interval.Duration(), start, count)
+		if len(bars) == 0 {
+			return nil, nil
+		}
+		from, to = bars[0].Start.Add(-interval.Duration()), start
+	default:
+		return nil, nil
+	}
+
+	candlesticks, err := r.candlestickRepository.GetCandlesticks(ctx, symbol, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return takeCount(candlesticks, direction, count), nil
+}
@@ -1,17 +1,61 @@
 package candlestick
 
 import (
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 )
 
 type Interval string
 
 const (
-	IntervalHour Interval = "1h"
+	IntervalMinute    Interval = "1m"
+	Interval5Minutes  Interval = "5m"
+	Interval15Minutes Interval = "15m"
+	IntervalHour      Interval = "1h"
+	Interval4Hours    Interval = "4h"
+	IntervalDay       Interval = "1d"
+	IntervalWeek      Interval = "1w"
 )
 
+var intervalDurations = map[Interval]time.Duration{
+	IntervalMinute:    time.Minute,
+	Interval5Minutes:  5 * time.Minute,
+	Interval15Minutes: 15 * time.Minute,
+	IntervalHour:      time.Hour,
+	Interval4Hours:    4 * time.Hour,
+	IntervalDay:       24 * time.Hour,
+	IntervalWeek:      7 * 24 * time.Hour,
+}
+
+func ParseInterval(s string) (Interval, error) {
+	interval := Interval(s)
+	if _, ok := intervalDurations[interval]; !ok {
+		return "", errors.Errorf("unknown interval %q", s)
+	}
+
+	return interval, nil
+}
+
+func ParseIntervals(s string) ([]Interval, error) {
+	var intervals []Interval
+	for _, v := range strings.Split(s, ",") {
+		interval, err := ParseInterval(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval)
+	}
+
+	return intervals, nil
+}
+
+func (r Interval) Duration() time.Duration {
+	return intervalDurations[r]
+}
+
 type Candlestick struct {
 	Open      decimal.Decimal `pg:",use_zero"`
 	Low       decimal.Decimal `pg:",use_zero"`
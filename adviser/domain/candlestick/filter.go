@@ -3,14 +3,15 @@ package candlestick
 import (
 	"context"
 	"time"
-
-	"github.com/shopspring/decimal"
 )
 
 type basicFilter struct {
 	repository Repository
 }
 
+// NewBasicFilter leaves out the candlesticks with no close or no volume, the quotes service quarantines them
+// since it validates what it loads, but the history loaded before that still has them.
+// A series having no volume at all, like the forex one, keeps its candlesticks.
 func NewBasicFilter(repository Repository) Repository {
 	return &basicFilter{repository}
 }
@@ -21,19 +22,50 @@ func (r basicFilter) GetCandlesticks(ctx context.Context, symbol string, interva
 		return nil, err
 	}
 
-	result := make([]Candlestick, len(cs))
-	resultIndex := 0
+	return r.filter(cs), nil
+}
+
+// StreamCandlesticks streams the range if the filtered repository is a Streamer.
+func (r basicFilter) StreamCandlesticks(ctx context.Context, symbol string, interval Interval, from, to time.Time, handle func(cs []Candlestick) error) error {
+	return Stream(ctx, r.repository, symbol, interval, from, to, func(cs []Candlestick) error {
+		return handle(r.filter(cs))
+	})
+}
+
+func (r basicFilter) filter(cs []Candlestick) []Candlestick {
+	hasVolume := false
 	for i := range cs {
-		if cs[i].Close.Equals(decimal.NewFromInt(0)) || cs[i].Volume == 0 {
+		if cs[i].Volume > 0 {
+			hasVolume = true
+			break
+		}
+	}
+
+	result := make([]Candlestick, 0, len(cs))
+	for i := range cs {
+		if !cs[i].Close.IsPositive() || hasVolume && cs[i].Volume <= 0 {
 			continue
 		}
-		result[resultIndex] = cs[i]
-		resultIndex++
+		result = append(result, cs[i])
 	}
 
-	return result[:resultIndex], nil
+	return result
 }
 
+func (r basicFilter) GetCandlesticksBatch(ctx context.Context, symbols []string, interval Interval, from, to time.Time) (map[string][]Candlestick, error) {
+	batch, err := r.repository.GetCandlesticksBatch(ctx, symbols, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for symbol := range batch {
+		batch[symbol] = r.filter(batch[symbol])
+	}
+
+	return batch, nil
+}
+
+// GetCandlesticksByCount reads more candlesticks as long as some are left out, so the count is kept unless the history is shorter.
 func (r basicFilter) GetCandlesticksByCount(
 	ctx context.Context,
 	symbol string,
@@ -42,5 +74,16 @@ func (r basicFilter) GetCandlesticksByCount(
 	direction GetterDirection,
 	count int,
 ) ([]Candlestick, error) {
-	return NewGreedyGetter(r).GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
+	for limit := count; ; {
+		cs, err := r.repository.GetCandlesticksByCount(ctx, symbol, interval, start, direction, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := r.filter(cs)
+		if len(filtered) >= count || len(cs) < limit {
+			return takeCount(filtered, direction, count), nil
+		}
+		limit += count - len(filtered)
+	}
 }
@@ -0,0 +1,105 @@
+package candlestick
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// memoryRepository serves the same candlesticks to every symbol
+type memoryRepository struct {
+	Repository
+	candlesticks []Candlestick
+}
+
+func (r memoryRepository) GetCandlesticks(_ context.Context, _ string, _ Interval, from, to time.Time) ([]Candlestick, error) {
+	var result []Candlestick
+	for _, c := range r.candlesticks {
+		if !c.Timestamp.Before(from) && !c.Timestamp.After(to) {
+			result = append(result, c)
+		}
+	}
+
+	return result, nil
+}
+
+func (r memoryRepository) GetCandlesticksByCount(
+	_ context.Context,
+	_ string,
+	_ Interval,
+	start time.Time,
+	_ GetterDirection,
+	count int,
+) ([]Candlestick, error) {
+	var result []Candlestick
+	for _, c := range r.candlesticks {
+		if !c.Timestamp.After(start) {
+			result = append(result, c)
+		}
+	}
+
+	return takeCount(result, GetterDirectionBackward, count), nil
+}
+
+func TestBasicFilter(t *testing.T) {
+	hour := func(h int, close int64, volume int) Candlestick {
+		return Candlestick{
+			Close:     decimal.NewFromInt(close),
+			Volume:    volume,
+			Timestamp: time.Date(2021, 3, 1, h, 30, 0, 0, time.UTC),
+			Interval:  IntervalHour,
+		}
+	}
+	ctx := context.Background()
+	from, to := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	filter := NewBasicFilter(memoryRepository{candlesticks: []Candlestick{
+		hour(9, 100, 10),
+		hour(10, 0, 10),  // zero close
+		hour(11, 100, 0), // zero volume
+		hour(12, 100, 10),
+		hour(13, 100, 10),
+	}})
+
+	cs, err := filter.GetCandlesticks(ctx, "AAPL", IntervalHour, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 3 || cs[1].Timestamp.Hour() != 12 {
+		t.Error(cs)
+	}
+
+	// the left out ones are made up for by earlier candlesticks
+	cs, err = filter.GetCandlesticksByCount(ctx, "AAPL", IntervalHour, to, GetterDirectionBackward, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 3 || cs[0].Timestamp.Hour() != 9 {
+		t.Error(cs)
+	}
+
+	err = Stream(ctx, filter, "AAPL", IntervalHour, from, to, func(cs []Candlestick) error {
+		if len(cs) != 3 {
+			t.Error(cs)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// forex has no volume at all
+	cs, err = NewBasicFilter(memoryRepository{candlesticks: []Candlestick{
+		hour(9, 100, 0),
+		hour(10, 0, 0),
+		hour(11, 100, 0),
+	}}).GetCandlesticks(ctx, "EURUSD", IntervalHour, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 2 {
+		t.Error(cs)
+	}
+}
@@ -47,17 +47,23 @@ func (r greedyGetter) GetCandlesticksByCount(
 	to := start
 	switch direction {
 	case GetterDirectionForward:
-		to = to.Add(time.Duration(count*10) * time.Hour)
+		to = to.Add(time.Duration(count*10) * interval.Duration())
 	case GetterDirectionBackward:
-		from = from.Add(-time.Duration(count*10) * time.Hour)
+		from = from.Add(-time.Duration(count*10) * interval.Duration())
 	}
 
 	candlesticks, err := r.candlestickRepository.GetCandlesticks(ctx, symbol, interval, from, to)
 	if err != nil {
 		return nil, err
 	}
+
+	return takeCount(candlesticks, direction, count), nil
+}
+
+// takeCount keeps count candlesticks closest to the start
+func takeCount(candlesticks []Candlestick, direction GetterDirection, count int) []Candlestick {
 	if len(candlesticks) <= count {
-		return candlesticks, nil
+		return candlesticks
 	}
 
 	result := make([]Candlestick, count)
@@ -68,5 +74,5 @@ func (r greedyGetter) GetCandlesticksByCount(
 		copy(result, candlesticks[len(candlesticks)-count:])
 	}
 
-	return result, nil
+	return result
 }
@@ -0,0 +1,91 @@
+package candlestick
+
+import (
+	"context"
+	"time"
+)
+
+type preloadedRepository struct {
+	repository   Repository
+	candlesticks map[string][]Candlestick
+	interval     Interval
+	from, to     time.Time
+}
+
+// NewPreloadedRepository loads the range of all the symbols in one batch and serves it from memory,
+// requests reaching outside of the range go to the repository.
+func NewPreloadedRepository(
+	ctx context.Context,
+	repository Repository,
+	symbols []string,
+	interval Interval,
+	from, to time.Time,
+) (Repository, error) {
+	candlesticks, err := repository.GetCandlesticksBatch(ctx, symbols, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preloadedRepository{
+		repository:   repository,
+		candlesticks: candlesticks,
+		interval:     interval,
+		from:         from,
+		to:           to,
+	}, nil
+}
+
+func (r preloadedRepository) GetCandlesticks(ctx context.Context, symbol string, interval Interval, from, to time.Time) ([]Candlestick, error) {
+	cs, ok := r.candlesticks[symbol]
+	if !ok || interval != r.interval || from.Before(r.from) || to.After(r.to) {
+		return r.repository.GetCandlesticks(ctx, symbol, interval, from, to)
+	}
+
+	var result []Candlestick
+	for i := range cs {
+		if !cs[i].Timestamp.Before(from) && !cs[i].Timestamp.After(to) {
+			result = append(result, cs[i])
+		}
+	}
+
+	return result, nil
+}
+
+func (r preloadedRepository) GetCandlesticksBatch(ctx context.Context, symbols []string, interval Interval, from, to time.Time) (map[string][]Candlestick, error) {
+	batch := make(map[string][]Candlestick, len(symbols))
+	for i := range symbols {
+		cs, err := r.GetCandlesticks(ctx, symbols[i], interval, from, to)
+		if err != nil {
+			return nil, err
+		}
+		batch[symbols[i]] = cs
+	}
+
+	return batch, nil
+}
+
+func (r preloadedRepository) GetCandlesticksByCount(
+	ctx context.Context,
+	symbol string,
+	interval Interval,
+	start time.Time,
+	direction GetterDirection,
+	count int,
+) ([]Candlestick, error) {
+	// the preloaded range is complete, so enough candlesticks within it are exactly the ones the repository would return
+	cs, ok := r.candlesticks[symbol]
+	if ok && interval == r.interval && !start.Before(r.from) && !start.After(r.to) {
+		var result []Candlestick
+		for i := range cs {
+			ts := cs[i].Timestamp
+			if direction == GetterDirectionBackward && !ts.After(start) || direction == GetterDirectionForward && !ts.Before(start) {
+				result = append(result, cs[i])
+			}
+		}
+		if len(result) >= count {
+			return takeCount(result, direction, count), nil
+		}
+	}
+
+	return r.repository.GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
+}
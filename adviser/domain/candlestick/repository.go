@@ -7,5 +7,32 @@ import (
 
 type Repository interface {
 	GetCandlesticks(ctx context.Context, symbol string, interval Interval, from, to time.Time) ([]Candlestick, error)
+	GetCandlesticksBatch(ctx context.Context, symbols []string, interval Interval, from, to time.Time) (map[string][]Candlestick, error)
 	GetCandlesticksByCount(ctx context.Context, symbol string, interval Interval, start time.Time, direction GetterDirection, count int) ([]Candlestick, error)
 }
+
+// Streamer passes the candlesticks of a range to handle in ordered chunks as they arrive,
+// so a long range is never held at once. It returns when the range is over or handle fails.
+type Streamer interface {
+	StreamCandlesticks(ctx context.Context, symbol string, interval Interval, from, to time.Time, handle func(cs []Candlestick) error) error
+}
+
+// Stream streams the range if the repository is a Streamer, otherwise handle gets the whole range at once.
+func Stream(ctx context.Context, repository Repository, symbol string, interval Interval, from, to time.Time, handle func(cs []Candlestick) error) error {
+	if streamer, ok := repository.(Streamer); ok {
+		return streamer.StreamCandlesticks(ctx, symbol, interval, from, to, handle)
+	}
+
+	cs, err := repository.GetCandlesticks(ctx, symbol, interval, from, to)
+	if err != nil {
+		return err
+	}
+
+	return handle(cs)
+}
+
+// Subscriber calls handle with every candlestick of the symbols closed since the subscription,
+// it returns when the context is done, the subscription breaks or handle fails.
+type Subscriber interface {
+	SubscribeCandlesticks(ctx context.Context, symbols []string, interval Interval, handle func(symbol string, c Candlestick) error) error
+}
@@ -0,0 +1,28 @@
+package params
+
+import (
+	"time"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+)
+
+const (
+	ScoreFrequency = "frequency"
+	ScoreAccuracy  = "accuracy"
+)
+
+// Document carries the params with the optimization they were found by, the legacy params have no metadata.
+type Document struct {
+	AdviserType advice.AdviserType `json:"adviser_type" yaml:"adviser_type"`
+	Params      advice.Params      `json:"params" yaml:"params"`
+	Period      *Period            `json:"period,omitempty" yaml:"period,omitempty"`
+	// Scores are the values of the objectives by their names, in percents
+	Scores    map[string]float64 `json:"scores,omitempty" yaml:"scores,omitempty"`
+	Symbols   []string           `json:"symbols,omitempty" yaml:"symbols,omitempty"`
+	CreatedAt time.Time          `json:"created_at" yaml:"created_at"`
+}
+
+type Period struct {
+	From time.Time `json:"from" yaml:"from"`
+	To   time.Time `json:"to" yaml:"to"`
+}
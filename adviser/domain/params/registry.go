@@ -0,0 +1,72 @@
+package params
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+)
+
+// Version is an immutable document of the params, numbered from 1 for every params name.
+type Version struct {
+	Name   string `json:"name" yaml:"name"`
+	Number int    `json:"number" yaml:"number"`
+	// Parent is the version live when this one was added, the one it was meant to replace
+	Parent   int       `json:"parent,omitempty" yaml:"parent,omitempty"`
+	Document Document  `json:"document" yaml:"document"`
+	AddedAt  time.Time `json:"added_at" yaml:"added_at"`
+}
+
+// Promotion records the version made live, a rollback makes the one live before the rolled back version live again.
+type Promotion struct {
+	Version    int       `json:"version" yaml:"version"`
+	Rollback   bool      `json:"rollback,omitempty" yaml:"rollback,omitempty"`
+	PromotedAt time.Time `json:"promoted_at" yaml:"promoted_at"`
+}
+
+var (
+	ErrNothingToRollback = errors.New("no version was live before the current one")
+	ErrAlreadyLive       = errors.New("the version is live already")
+)
+
+// Registry keeps every version of the params and the history of the live ones.
+type Registry interface {
+	// AddVersion stores the document as the next version of the params, it isn't live until promoted.
+	AddVersion(name string, doc Document) (*Version, error)
+	// GetVersion returns the version with the params validated by the schema.
+	GetVersion(name string, number int, schema advice.ParamsSchema) (*Version, error)
+	ListVersions(name string) ([]Version, error)
+	// GetLive returns the promoted version with the params validated by the schema, nil if none is promoted.
+	GetLive(name string, schema advice.ParamsSchema) (*Version, error)
+	// Promote returns ErrAlreadyLive for the live version, promoting it again would make a rollback return to it.
+	Promote(name string, number int) (*Promotion, error)
+	Rollback(name string) (*Promotion, error)
+	GetPromotions(name string) ([]Promotion, error)
+}
+
+// LiveStack replays the promotions to the versions a rollback returns to, the live one is the last.
+func LiveStack(promotions []Promotion) []int {
+	var stack []int
+	for i := range promotions {
+		if promotions[i].Rollback {
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+		stack = append(stack, promotions[i].Version)
+	}
+
+	return stack
+}
+
+// NextRollback returns the promotion of the version a rollback makes live.
+func NextRollback(promotions []Promotion, now time.Time) (*Promotion, error) {
+	stack := LiveStack(promotions)
+	if len(stack) < 2 {
+		return nil, ErrNothingToRollback
+	}
+
+	return &Promotion{Version: stack[len(stack)-2], Rollback: true, PromotedAt: now}, nil
+}
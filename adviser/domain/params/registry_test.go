@@ -0,0 +1,31 @@
+package params
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextRollback(t *testing.T) {
+	now := time.Now()
+	promotions := []Promotion{{Version: 1}, {Version: 2}, {Version: 3}}
+
+	p, err := NextRollback(promotions, now)
+	if err != nil || p.Version != 2 || !p.Rollback {
+		t.Fatal(p, err)
+	}
+	promotions = append(promotions, *p)
+
+	p, err = NextRollback(promotions, now)
+	if err != nil || p.Version != 1 {
+		t.Fatal(p, err)
+	}
+	promotions = append(promotions, *p, Promotion{Version: 4})
+
+	if stack := LiveStack(promotions); len(stack) != 2 || stack[0] != 1 || stack[1] != 4 {
+		t.Error(stack)
+	}
+
+	if _, err := NextRollback([]Promotion{{Version: 1}}, now); err != ErrNothingToRollback {
+		t.Error(err)
+	}
+}
@@ -1,8 +1,9 @@
 package params
 
-import "github.com/shopspring/decimal"
+import "github.com/websmee/example_of_my_code/adviser/domain/advice"
 
 type Repository interface {
-	SaveParams(name string, params []decimal.Decimal) error
-	LoadParams(name string) ([]decimal.Decimal, error)
+	SaveParams(name string, doc Document) error
+	// LoadParams returns the document of the params validated by the schema.
+	LoadParams(name string, schema advice.ParamsSchema) (*Document, error)
 }
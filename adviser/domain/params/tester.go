@@ -4,8 +4,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/shopspring/decimal"
-
 	"github.com/websmee/example_of_my_code/adviser/domain/advice"
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
 	"github.com/websmee/example_of_my_code/adviser/domain/quote"
@@ -17,7 +15,7 @@ type AdviserParamsTester interface {
 	TestParams(
 		ctx context.Context,
 		adviser advice.Adviser,
-		prms []decimal.Decimal,
+		prms advice.Params,
 		quote quote.Quote,
 		from, to time.Time,
 		advicesChan chan []advice.InternalAdvice,
@@ -40,53 +38,57 @@ func NewAdviserParamsTester(candlestickRepository candlestick.Repository) Advise
 func (r adviserParamsTester) TestParams(
 	ctx context.Context,
 	adviser advice.Adviser,
-	prms []decimal.Decimal,
+	prms advice.Params,
 	quote quote.Quote,
 	from, to time.Time,
 	advicesChan chan []advice.InternalAdvice,
 ) {
-	hours, err := r.candlestickRepository.GetCandlesticks(ctx, quote.Symbol, candlestick.IntervalHour, from, to)
-	if err != nil {
-		panic(err)
-	}
+	// the hours are tested chunk by chunk as they arrive, a long range isn't held at once
+	err := candlestick.Stream(ctx, r.candlestickRepository, quote.Symbol, candlestick.IntervalHour, from, to, func(hours []candlestick.Candlestick) error {
+		for i := range hours {
+			advices, err := adviser.GetAdvices(ctx, prms, hours[i], quote.Symbol)
+			if err != nil {
+				return err
+			}
 
-	for i := range hours {
-		advices, err := adviser.GetAdvices(ctx, prms, hours[i], quote.Symbol)
-		if err != nil {
-			panic(err)
-		}
+			for j := range advices {
+				if advices[j].Status == advice.StatusOK {
+					expirationPeriod, err := r.candlestickRepository.GetCandlesticks(
+						ctx,
+						quote.Symbol,
+						candlestick.IntervalHour,
+						hours[i].Timestamp.Add(time.Hour),
+						hours[i].Timestamp.Add(TestOrderExpirationPeriod),
+					)
+					if err != nil {
+						return err
+					}
 
-		for j := range advices {
-			if advices[j].Status == advice.StatusOK {
-				expirationPeriod, err := r.candlestickRepository.GetCandlesticks(
-					ctx,
-					quote.Symbol,
-					candlestick.IntervalHour,
-					hours[i].Timestamp.Add(time.Hour),
-					hours[i].Timestamp.Add(TestOrderExpirationPeriod),
-				)
-				if err != nil {
-					panic(err)
+					advices[j].OrderResult, advices[j].OrderClosed = r.calc.CalculateOrderResult(
+						advices[j].CurrentPrice,
+						advices[j].TakeProfit,
+						advices[j].StopLoss,
+						expirationPeriod,
+					)
 				}
-
-				advices[j].OrderResult, advices[j].OrderClosed = r.calc.CalculateOrderResult(
-					advices[j].CurrentPrice,
-					advices[j].TakeProfit,
-					advices[j].StopLoss,
-					expirationPeriod,
-				)
 			}
+
+			advicesChan <- advices
 		}
 
-		advicesChan <- advices
+		return nil
+	})
+	if err != nil {
+		panic(err)
 	}
 }
 
 func (r adviserParamsTester) GetTotalSteps(ctx context.Context, quote quote.Quote, from, to time.Time) (int, error) {
-	hours, err := r.candlestickRepository.GetCandlesticks(ctx, quote.Symbol, candlestick.IntervalHour, from, to)
-	if err != nil {
-		return 0, err
-	}
+	steps := 0
+	err := candlestick.Stream(ctx, r.candlestickRepository, quote.Symbol, candlestick.IntervalHour, from, to, func(hours []candlestick.Candlestick) error {
+		steps += len(hours)
+		return nil
+	})
 
-	return len(hours), nil
+	return steps, err
 }
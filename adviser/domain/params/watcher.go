@@ -0,0 +1,9 @@
+package params
+
+import "context"
+
+// Watcher calls changed with the live version of the params every time it changes, starting with the current one,
+// until the context is done. A version that can't be read is passed as an error, the watching goes on.
+type Watcher interface {
+	Watch(ctx context.Context, name string, changed func(v *Version, err error)) error
+}
@@ -0,0 +1,19 @@
+package quote
+
+import (
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+// NewCalendars maps the symbols of the quotes to the calendars of their markets.
+func NewCalendars(quotes []Quote) (calendar.Calendars, error) {
+	calendars := make(calendar.Calendars, len(quotes))
+	for i := range quotes {
+		c, err := calendar.ForMarket(quotes[i].Exchange, quotes[i].Timezone, quotes[i].TradingHours)
+		if err != nil {
+			return nil, err
+		}
+		calendars[quotes[i].Symbol] = c
+	}
+
+	return calendars, nil
+}
@@ -1,7 +1,19 @@
 package quote
 
 type Quote struct {
-	ID     int64
-	Symbol string
-	Name   string
+	ID           int64
+	Symbol       string
+	Name         string
+	Exchange     string
+	Currency     string
+	AssetClass   string
+	Timezone     string
+	TradingHours string
+}
+
+// TradingHoursAlways marks instruments trading around the clock, like crypto.
+const TradingHoursAlways = "24/7"
+
+func (r Quote) IsAlwaysTrading() bool {
+	return r.TradingHours == TradingHoursAlways
 }
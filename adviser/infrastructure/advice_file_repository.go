@@ -4,6 +4,7 @@ import (
 	"encoding/csv"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -80,8 +81,13 @@ func adviceToRecord(advice advice.InternalAdvice) []string {
 		strconv.Itoa(int(advice.OrderClosed.Unix())),
 		string(advice.AdviserType),
 	}
-	for i := range advice.AdviserParams {
-		record = append(record, advice.AdviserParams[i].String())
+	names := make([]string, 0, len(advice.AdviserParams))
+	for name := range advice.AdviserParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		record = append(record, name+"="+advice.AdviserParams[name].String())
 	}
 
 	return record
@@ -102,9 +108,12 @@ func recordToAdvice(record []string) advice.InternalAdvice {
 	orderClosed, _ := strconv.Atoi(record[10])
 	adviserType := advice.AdviserType(record[11])
 
-	adviserParams := make([]decimal.Decimal, len(record)-12)
-	for i, r := range record[12:] {
-		adviserParams[i], _ = decimal.NewFromString(r)
+	// the files of the positional params have no names to read them by
+	adviserParams := make(advice.Params, len(record)-12)
+	for _, r := range record[12:] {
+		if pair := strings.SplitN(r, "=", 2); len(pair) == 2 {
+			adviserParams[pair[0]], _ = decimal.NewFromString(pair[1])
+		}
 	}
 
 	return advice.InternalAdvice{
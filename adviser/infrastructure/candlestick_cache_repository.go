@@ -7,12 +7,14 @@ import (
 
 	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
 	"github.com/websmee/example_of_my_code/adviser/domain/quote"
+	"github.com/websmee/example_of_my_code/calendar"
 )
 
 type candlestickCacheRepository struct {
 	quoteRepository       quote.Repository
 	candlestickRepository candlestick.Repository
 	cache                 map[string]map[candlestick.Interval][]candlestick.Candlestick
+	calendars             calendar.Calendars
 	lock                  sync.RWMutex
 }
 
@@ -47,16 +49,24 @@ func (r *candlestickCacheRepository) makeCache(ctx context.Context, intervals []
 		symbols[i] = quotes[i].Symbol
 	}
 
+	r.calendars, err = quote.NewCalendars(quotes)
+	if err != nil {
+		return err
+	}
+
 	r.cache = make(map[string]map[candlestick.Interval][]candlestick.Candlestick)
 	for i := range symbols {
 		r.cache[symbols[i]] = make(map[candlestick.Interval][]candlestick.Candlestick)
-		for j := range intervals {
-			cs, err := r.candlestickRepository.GetCandlesticks(ctx, symbols[i], intervals[j], from, to)
-			if err != nil {
-				return err
-			}
+	}
+
+	for j := range intervals {
+		batch, err := r.candlestickRepository.GetCandlesticksBatch(ctx, symbols, intervals[j], from, to)
+		if err != nil {
+			return err
+		}
 
-			r.cache[symbols[i]][intervals[j]] = cs
+		for i := range symbols {
+			r.cache[symbols[i]][intervals[j]] = batch[symbols[i]]
 		}
 	}
 
@@ -84,6 +94,19 @@ func (r *candlestickCacheRepository) GetCandlesticks(_ context.Context, symbol s
 	return c, nil
 }
 
+func (r *candlestickCacheRepository) GetCandlesticksBatch(ctx context.Context, symbols []string, interval candlestick.Interval, from, to time.Time) (map[string][]candlestick.Candlestick, error) {
+	batch := make(map[string][]candlestick.Candlestick, len(symbols))
+	for i := range symbols {
+		cs, err := r.GetCandlesticks(ctx, symbols[i], interval, from, to)
+		if err != nil {
+			return nil, err
+		}
+		batch[symbols[i]] = cs
+	}
+
+	return batch, nil
+}
+
 func (r *candlestickCacheRepository) GetCandlesticksByCount(
 	ctx context.Context,
 	symbol string,
@@ -92,5 +115,5 @@ func (r *candlestickCacheRepository) GetCandlesticksByCount(
 	direction candlestick.GetterDirection,
 	count int,
 ) ([]candlestick.Candlestick, error) {
-	return candlestick.NewGreedyGetter(r).GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
+	return candlestick.NewCalendarGetter(r, r.calendars).GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
 }
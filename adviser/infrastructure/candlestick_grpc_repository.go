@@ -8,6 +8,9 @@ import (
 	"github.com/websmee/example_of_my_code/adviser/infrastructure/grpc"
 )
 
+// streamThreshold is the number of candlesticks a range must exceed to be streamed instead of fetched at once
+const streamThreshold = 5000
+
 type candlestickGRPCRepository struct {
 	quotesApp grpc.QuotesApp
 }
@@ -26,9 +29,40 @@ func (r candlestickGRPCRepository) GetCandlesticks(
 	interval candlestick.Interval,
 	from, to time.Time,
 ) ([]candlestick.Candlestick, error) {
+	if interval.Duration() > 0 && to.Sub(from)/interval.Duration() > streamThreshold {
+		var cs []candlestick.Candlestick
+		err := r.quotesApp.StreamCandlesticks(ctx, symbol, interval, from, to, func(chunk []candlestick.Candlestick) error {
+			cs = append(cs, chunk...)
+			return nil
+		})
+		return cs, err
+	}
+
 	return r.quotesApp.GetCandlesticks(ctx, symbol, interval, from, to)
 }
 
+// StreamCandlesticks makes the repository a candlestick.Streamer, the callers consuming the range
+// chunk by chunk don't wait for all of it and never hold it at once
+func (r candlestickGRPCRepository) StreamCandlesticks(
+	ctx context.Context,
+	symbol string,
+	interval candlestick.Interval,
+	from, to time.Time,
+	handle func(cs []candlestick.Candlestick) error,
+) error {
+	return r.quotesApp.StreamCandlesticks(ctx, symbol, interval, from, to, handle)
+}
+
+func (r candlestickGRPCRepository) GetCandlesticksBatch(
+	ctx context.Context,
+	symbols []string,
+	interval candlestick.Interval,
+	from, to time.Time,
+) (map[string][]candlestick.Candlestick, error) {
+	return r.quotesApp.GetCandlesticksBatch(ctx, symbols, interval, from, to)
+}
+
+// GetCandlesticksByCount is served by the quotes service, no getter guessing the range by the calendar is needed.
 func (r candlestickGRPCRepository) GetCandlesticksByCount(
 	ctx context.Context,
 	symbol string,
@@ -37,5 +71,5 @@ func (r candlestickGRPCRepository) GetCandlesticksByCount(
 	direction candlestick.GetterDirection,
 	count int,
 ) ([]candlestick.Candlestick, error) {
-	return candlestick.NewGreedyGetter(r).GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
+	return r.quotesApp.GetCandlesticksByCount(ctx, symbol, interval, start, direction, count)
 }
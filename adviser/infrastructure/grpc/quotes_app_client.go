@@ -21,14 +21,27 @@ import (
 	"github.com/websmee/example_of_my_code/adviser/domain/quote"
 )
 
+// adjustedPrices asks for series adjusted by splits and dividends, so the advisers don't take them for price moves
+const adjustedPrices = true
+
 type QuotesApp interface {
 	GetQuotes(ctx context.Context) ([]quote.Quote, error)
 	GetCandlesticks(ctx context.Context, symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error)
+	GetCandlesticksBatch(ctx context.Context, symbols []string, interval candlestick.Interval, from, to time.Time) (map[string][]candlestick.Candlestick, error)
+	StreamCandlesticks(ctx context.Context, symbol string, interval candlestick.Interval, from, to time.Time, handle func([]candlestick.Candlestick) error) error
+	GetCandlesticksByCount(ctx context.Context, symbol string, interval candlestick.Interval, start time.Time, direction candlestick.GetterDirection, count int) ([]candlestick.Candlestick, error)
+	SubscribeCandlesticks(ctx context.Context, symbols []string, interval candlestick.Interval, handle func(symbol string, c candlestick.Candlestick) error) error
 }
 
 type quotesAppGRPCClient struct {
-	getQuotesEndpoint       endpoint.Endpoint
-	getCandlesticksEndpoint endpoint.Endpoint
+	getQuotesEndpoint              endpoint.Endpoint
+	getCandlesticksEndpoint        endpoint.Endpoint
+	getCandlesticksBatchEndpoint   endpoint.Endpoint
+	streamCandlesticksEndpoint     endpoint.Endpoint
+	getCandlesticksByCountEndpoint endpoint.Endpoint
+	quotesClient                   proto.QuotesV2Client
+	otTracer                       stdopentracing.Tracer
+	logger                         log.Logger
 }
 
 func NewQuotesAppGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) QuotesApp {
@@ -42,7 +55,7 @@ func NewQuotesAppGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Trace
 	{
 		getQuotesEndpoint = grpctransport.NewClient(
 			conn,
-			"proto.Quotes",
+			"proto.QuotesV2",
 			"GetQuotes",
 			encodeGRPCGetQuotesRequest,
 			decodeGRPCGetQuotesResponse,
@@ -62,11 +75,11 @@ func NewQuotesAppGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Trace
 	{
 		getCandlesticksEndpoint = grpctransport.NewClient(
 			conn,
-			"proto.Quotes",
+			"proto.QuotesV2",
 			"GetCandlesticks",
 			encodeGRPCGetCandlesticksRequest,
 			decodeGRPCGetCandlesticksResponse,
-			proto.GetCandlesticksReply{},
+			proto.GetCandlesticksV2Reply{},
 			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
 		).Endpoint()
 		getCandlesticksEndpoint = opentracing.TraceClient(otTracer, "GetCandlesticks")(getCandlesticksEndpoint)
@@ -78,8 +91,63 @@ func NewQuotesAppGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Trace
 		getCandlesticksEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticks"))(getCandlesticksEndpoint)
 	}
 
+	var getCandlesticksBatchEndpoint endpoint.Endpoint
+	{
+		getCandlesticksBatchEndpoint = grpctransport.NewClient(
+			conn,
+			"proto.QuotesV2",
+			"GetCandlesticksBatch",
+			encodeGRPCGetCandlesticksBatchRequest,
+			decodeGRPCGetCandlesticksBatchResponse,
+			proto.GetCandlesticksBatchReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		getCandlesticksBatchEndpoint = opentracing.TraceClient(otTracer, "GetCandlesticksBatch")(getCandlesticksBatchEndpoint)
+		getCandlesticksBatchEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "GetCandlesticksBatch",
+			Timeout: 30 * time.Second,
+		}))(getCandlesticksBatchEndpoint)
+		getCandlesticksBatchEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticksBatch"))(getCandlesticksBatchEndpoint)
+	}
+
+	var streamCandlesticksEndpoint endpoint.Endpoint
+	{
+		streamCandlesticksEndpoint = makeStreamCandlesticksEndpoint(proto.NewQuotesV2Client(conn), otTracer, logger)
+		streamCandlesticksEndpoint = opentracing.TraceClient(otTracer, "StreamCandlesticks")(streamCandlesticksEndpoint)
+		streamCandlesticksEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "StreamCandlesticks",
+			Timeout: 30 * time.Second,
+		}))(streamCandlesticksEndpoint)
+		streamCandlesticksEndpoint = LoggingMiddleware(log.With(logger, "method", "StreamCandlesticks"))(streamCandlesticksEndpoint)
+	}
+
+	var getCandlesticksByCountEndpoint endpoint.Endpoint
+	{
+		getCandlesticksByCountEndpoint = grpctransport.NewClient(
+			conn,
+			"proto.QuotesV2",
+			"GetCandlesticksByCount",
+			encodeGRPCGetCandlesticksByCountRequest,
+			decodeGRPCGetCandlesticksResponse,
+			proto.GetCandlesticksV2Reply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		getCandlesticksByCountEndpoint = opentracing.TraceClient(otTracer, "GetCandlesticksByCount")(getCandlesticksByCountEndpoint)
+		getCandlesticksByCountEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "GetCandlesticksByCount",
+			Timeout: 30 * time.Second,
+		}))(getCandlesticksByCountEndpoint)
+		getCandlesticksByCountEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticksByCount"))(getCandlesticksByCountEndpoint)
+	}
+
 	return &quotesAppGRPCClient{
-		getQuotesEndpoint:       getQuotesEndpoint,
-		getCandlesticksEndpoint: getCandlesticksEndpoint,
+		getQuotesEndpoint:              getQuotesEndpoint,
+		getCandlesticksEndpoint:        getCandlesticksEndpoint,
+		getCandlesticksBatchEndpoint:   getCandlesticksBatchEndpoint,
+		streamCandlesticksEndpoint:     streamCandlesticksEndpoint,
+		getCandlesticksByCountEndpoint: getCandlesticksByCountEndpoint,
+		quotesClient:                   proto.NewQuotesV2Client(conn),
+		otTracer:                       otTracer,
+		logger:                         logger,
 	}
 }
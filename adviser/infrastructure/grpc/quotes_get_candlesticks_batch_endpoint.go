@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
+)
+
+func (r quotesAppGRPCClient) GetCandlesticksBatch(ctx context.Context, symbols []string, interval candlestick.Interval, from, to time.Time) (map[string][]candlestick.Candlestick, error) {
+	resp, err := r.getCandlesticksBatchEndpoint(ctx, GetCandlesticksBatchRequest{
+		Symbols:  symbols,
+		Interval: interval,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCandlesticksBatch failed")
+	}
+	if err := resp.(GetCandlesticksBatchResponse).Failed(); err != nil {
+		return nil, errors.Wrap(err, "GetCandlesticksBatch failed")
+	}
+
+	return resp.(GetCandlesticksBatchResponse).Candlesticks, nil
+}
+
+var (
+	_ endpoint.Failer = GetCandlesticksBatchResponse{}
+)
+
+type GetCandlesticksBatchRequest struct {
+	Symbols  []string
+	Interval candlestick.Interval
+	From, To time.Time
+}
+
+type GetCandlesticksBatchResponse struct {
+	Candlesticks map[string][]candlestick.Candlestick
+	Err          error
+}
+
+func (r GetCandlesticksBatchResponse) Failed() error { return r.Err }
+
+func decodeGRPCGetCandlesticksBatchResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*proto.GetCandlesticksBatchReply)
+	batch := make(map[string][]candlestick.Candlestick, len(reply.Items))
+	for _, item := range reply.Items {
+		cs := make([]candlestick.Candlestick, len(item.Candlesticks))
+		for i := range item.Candlesticks {
+			c, err := decodeCandlestickV2(item.Candlesticks[i])
+			if err != nil {
+				return nil, errors.Wrap(err, "decodeGRPCGetCandlesticksBatchResponse failed")
+			}
+			cs[i] = c
+		}
+		batch[item.Symbol] = cs
+	}
+
+	return GetCandlesticksBatchResponse{
+		Candlesticks: batch,
+		Err:          str2err(reply.Err),
+	}, nil
+}
+
+func encodeGRPCGetCandlesticksBatchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(GetCandlesticksBatchRequest)
+	return &proto.GetCandlesticksBatchRequest{
+		Symbols:  req.Symbols,
+		Interval: string(req.Interval),
+		From:     req.From.Format(time.RFC3339),
+		To:       req.To.Format(time.RFC3339),
+		Adjusted: adjustedPrices,
+	}, nil
+}
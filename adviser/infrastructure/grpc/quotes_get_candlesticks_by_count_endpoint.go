@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
+)
+
+func (r quotesAppGRPCClient) GetCandlesticksByCount(
+	ctx context.Context,
+	symbol string,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.GetterDirection,
+	count int,
+) ([]candlestick.Candlestick, error) {
+	resp, err := r.getCandlesticksByCountEndpoint(ctx, GetCandlesticksByCountRequest{
+		Symbol:    symbol,
+		Interval:  interval,
+		Start:     start,
+		Direction: direction,
+		Count:     count,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCandlesticksByCount failed")
+	}
+	if err := resp.(GetCandlesticksResponse).Failed(); err != nil {
+		return nil, errors.Wrap(err, "GetCandlesticksByCount failed")
+	}
+
+	return resp.(GetCandlesticksResponse).Candlesticks, nil
+}
+
+type GetCandlesticksByCountRequest struct {
+	Symbol    string
+	Interval  candlestick.Interval
+	Start     time.Time
+	Direction candlestick.GetterDirection
+	Count     int
+}
+
+func encodeGRPCGetCandlesticksByCountRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(GetCandlesticksByCountRequest)
+	return &proto.GetCandlesticksByCountRequest{
+		Symbol:    req.Symbol,
+		Interval:  string(req.Interval),
+		Start:     req.Start.Format(time.RFC3339),
+		Direction: string(req.Direction),
+		Count:     int32(req.Count),
+		Adjusted:  adjustedPrices,
+	}, nil
+}
@@ -22,6 +22,9 @@ func (r quotesAppGRPCClient) GetCandlesticks(ctx context.Context, symbol string,
 	if err != nil {
 		return nil, errors.Wrap(err, "GetCandlesticks failed")
 	}
+	if err := resp.(GetCandlesticksResponse).Failed(); err != nil {
+		return nil, errors.Wrap(err, "GetCandlesticks failed")
+	}
 
 	return resp.(GetCandlesticksResponse).Candlesticks, nil
 }
@@ -44,25 +47,19 @@ type GetCandlesticksResponse struct {
 func (r GetCandlesticksResponse) Failed() error { return r.Err }
 
 func decodeGRPCGetCandlesticksResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
-	reply := grpcReply.(*proto.GetCandlesticksReply)
+	reply := grpcReply.(*proto.GetCandlesticksV2Reply)
 	cs := make([]candlestick.Candlestick, len(reply.Candlesticks))
 	for i := range reply.Candlesticks {
-		cs[i] = candlestick.Candlestick{
-			Open:      decimal.NewFromFloat32(reply.Candlesticks[i].Open),
-			Low:       decimal.NewFromFloat32(reply.Candlesticks[i].Low),
-			High:      decimal.NewFromFloat32(reply.Candlesticks[i].High),
-			Close:     decimal.NewFromFloat32(reply.Candlesticks[i].Close),
-			AdjClose:  decimal.NewFromFloat32(reply.Candlesticks[i].AdjClose),
-			Volume:    int(reply.Candlesticks[i].Volume),
-			Timestamp: time.Unix(reply.Candlesticks[i].Timestamp, 0),
-			Interval:  candlestick.Interval(reply.Candlesticks[i].Interval),
-			QuoteID:   reply.Candlesticks[i].QuoteId,
+		c, err := decodeCandlestickV2(reply.Candlesticks[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "decodeGRPCGetCandlesticksResponse failed")
 		}
+		cs[i] = c
 	}
 
 	return GetCandlesticksResponse{
 		Candlesticks: cs,
-		Err:          nil,
+		Err:          str2err(reply.Err),
 	}, nil
 }
 
@@ -73,5 +70,45 @@ func encodeGRPCGetCandlesticksRequest(_ context.Context, request interface{}) (i
 		Interval: string(req.Interval),
 		From:     req.From.Format(time.RFC3339),
 		To:       req.To.Format(time.RFC3339),
+		Adjusted: adjustedPrices,
+	}, nil
+}
+
+func decodeCandlestickV2(c *proto.CandlestickV2) (candlestick.Candlestick, error) {
+	prices, err := parseDecimals(c.Open, c.Low, c.High, c.Close, c.AdjClose)
+	if err != nil {
+		return candlestick.Candlestick{}, err
+	}
+
+	return candlestick.Candlestick{
+		Open:      prices[0],
+		Low:       prices[1],
+		High:      prices[2],
+		Close:     prices[3],
+		AdjClose:  prices[4],
+		Volume:    int(c.Volume),
+		Timestamp: time.Unix(c.Timestamp, 0),
+		Interval:  candlestick.Interval(c.Interval),
+		QuoteID:   c.QuoteId,
 	}, nil
 }
+
+func parseDecimals(values ...string) ([]decimal.Decimal, error) {
+	ds := make([]decimal.Decimal, len(values))
+	for i := range values {
+		d, err := decimal.NewFromString(values[i])
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+
+	return ds, nil
+}
+
+func str2err(s string) error {
+	if s == "" {
+		return nil
+	}
+	return errors.New(s)
+}
@@ -37,9 +37,14 @@ func decodeGRPCGetQuotesResponse(_ context.Context, grpcReply interface{}) (inte
 	qs := make([]quote.Quote, len(reply.Quotes))
 	for i := range reply.Quotes {
 		qs[i] = quote.Quote{
-			ID:     reply.Quotes[i].Id,
-			Symbol: reply.Quotes[i].Symbol,
-			Name:   reply.Quotes[i].Name,
+			ID:           reply.Quotes[i].Id,
+			Symbol:       reply.Quotes[i].Symbol,
+			Name:         reply.Quotes[i].Name,
+			Exchange:     reply.Quotes[i].Exchange,
+			Currency:     reply.Quotes[i].Currency,
+			AssetClass:   reply.Quotes[i].AssetClass,
+			Timezone:     reply.Quotes[i].Timezone,
+			TradingHours: reply.Quotes[i].TradingHours,
 		}
 	}
 
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/tracing/opentracing"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
+)
+
+func (r quotesAppGRPCClient) StreamCandlesticks(
+	ctx context.Context,
+	symbol string,
+	interval candlestick.Interval,
+	from, to time.Time,
+	handle func([]candlestick.Candlestick) error,
+) error {
+	_, err := r.streamCandlesticksEndpoint(ctx, StreamCandlesticksRequest{
+		GetCandlesticksRequest: GetCandlesticksRequest{
+			Symbol:   symbol,
+			Interval: interval,
+			From:     from,
+			To:       to,
+		},
+		Handle: handle,
+	})
+
+	return errors.Wrap(err, "StreamCandlesticks failed")
+}
+
+// StreamCandlesticksRequest carries the handle of the chunks, go-kit transport doesn't support streaming
+type StreamCandlesticksRequest struct {
+	GetCandlesticksRequest
+	Handle func([]candlestick.Candlestick) error
+}
+
+// makeStreamCandlesticksEndpoint passes every chunk to the handle of the request as soon as it is received
+func makeStreamCandlesticksEndpoint(client proto.QuotesV2Client, otTracer stdopentracing.Tracer, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(StreamCandlesticksRequest)
+
+		md := metadata.MD{}
+		ctx = opentracing.ContextToGRPC(otTracer, logger)(ctx, &md)
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		// the stream is cancelled if handle fails before it is over
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := client.StreamCandlesticks(ctx, &proto.StreamCandlesticksRequest{
+			Symbol:   req.Symbol,
+			Interval: string(req.Interval),
+			From:     req.From.Format(time.RFC3339),
+			To:       req.To.Format(time.RFC3339),
+			Adjusted: adjustedPrices,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			cs := make([]candlestick.Candlestick, len(chunk.Candlesticks))
+			for i := range chunk.Candlesticks {
+				if cs[i], err = decodeCandlestickV2(chunk.Candlesticks[i]); err != nil {
+					return nil, errors.Wrap(err, "StreamCandlesticks decode failed")
+				}
+			}
+
+			if err := req.Handle(cs); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
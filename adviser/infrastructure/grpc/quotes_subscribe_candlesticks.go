@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/candlestick"
+)
+
+// SubscribeCandlesticks holds the stream open as long as the subscriber wants it, so it goes around
+// the endpoint middlewares made for short calls, like the circuit breaker.
+func (r quotesAppGRPCClient) SubscribeCandlesticks(
+	ctx context.Context,
+	symbols []string,
+	interval candlestick.Interval,
+	handle func(symbol string, c candlestick.Candlestick) error,
+) error {
+	md := metadata.MD{}
+	ctx = opentracing.ContextToGRPC(r.otTracer, r.logger)(ctx, &md)
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := r.quotesClient.SubscribeCandlesticks(ctx, &proto.SubscribeCandlesticksRequest{
+		Symbols:  symbols,
+		Interval: string(interval),
+	})
+	if err != nil {
+		return errors.Wrap(err, "SubscribeCandlesticks failed")
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "SubscribeCandlesticks receive failed")
+		}
+
+		c, err := decodeCandlestickV2(event.Candlestick)
+		if err != nil {
+			return errors.Wrap(err, "SubscribeCandlesticks decode failed")
+		}
+
+		if err := handle(event.Symbol, c); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/hashicorp/consul/api"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+const (
+	consulWaitTime   = 5 * time.Minute
+	consulRetryDelay = 5 * time.Second
+)
+
+// paramsConsulWatcher watches the live version of the params kept as YAML or JSON in the key <prefix><name>.
+// Consul replaces a value as a whole, so a half-written one is never read.
+type paramsConsulWatcher struct {
+	logger log.Logger
+	kv     *api.KV
+	prefix string
+}
+
+func NewParamsConsulWatcher(logger log.Logger, client *api.Client, prefix string) params.Watcher {
+	return &paramsConsulWatcher{
+		logger: logger,
+		kv:     client.KV(),
+		prefix: prefix,
+	}
+}
+
+func (r *paramsConsulWatcher) Watch(ctx context.Context, name string, changed func(v *params.Version, err error)) error {
+	key := r.prefix + name
+	var index uint64
+	for {
+		pair, meta, err := r.kv.Get(key, (&api.QueryOptions{WaitIndex: index, WaitTime: consulWaitTime}).WithContext(ctx))
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			changed(nil, errors.Wrap(err, "Watch consul get failed"))
+			select {
+			case <-time.After(consulRetryDelay):
+				continue
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		// the index going backwards means consul was reset, the blocking starts over
+		if meta.LastIndex < index {
+			index = 0
+			continue
+		}
+		if meta.LastIndex == index {
+			continue
+		}
+		index = meta.LastIndex
+
+		// the live params stay as they are, a deleted key isn't a version to run by
+		if pair == nil {
+			_ = r.logger.Log("method", "Watch", "params", name, "key", key, "deleted", true)
+			continue
+		}
+		v, err := r.decode(name, pair.Value)
+		if err != nil {
+			changed(nil, err)
+			continue
+		}
+		changed(v, nil)
+	}
+}
+
+// decode reads JSON as well, it is a subset of YAML. The number is required, the advices are traced back by it.
+func (r *paramsConsulWatcher) decode(name string, data []byte) (*params.Version, error) {
+	v := new(params.Version)
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return nil, errors.Wrapf(err, "decoding params %s failed", name)
+	}
+	if v.Number <= 0 {
+		return nil, errors.Errorf("params %s have no version number", name)
+	}
+	if v.Name == "" {
+		v.Name = path.Base(name)
+	}
+
+	return v, nil
+}
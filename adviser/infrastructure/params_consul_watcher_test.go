@@ -0,0 +1,148 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/hashicorp/consul/api"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+// consulKVStub answers the blocking reads of a key by the scripted responses, then blocks until the read is cancelled
+type consulKVStub struct {
+	mu        sync.Mutex
+	responses []consulKVResponse
+	indexes   []string
+}
+
+type consulKVResponse struct {
+	index uint64
+	value string // the key is deleted if empty
+}
+
+func (r *consulKVStub) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.indexes = append(r.indexes, req.URL.Query().Get("index"))
+	if len(r.responses) == 0 {
+		r.mu.Unlock()
+		<-req.Context().Done()
+		return
+	}
+	resp := r.responses[0]
+	r.responses = r.responses[1:]
+	r.mu.Unlock()
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(resp.index, 10))
+	if resp.value == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode([]api.KVPair{{Key: "params/CBS", Value: []byte(resp.value), ModifyIndex: resp.index}})
+}
+
+func (r *consulKVStub) requests() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.indexes)
+}
+
+func TestParamsConsulWatcher_Watch(t *testing.T) {
+	stub := &consulKVStub{responses: []consulKVResponse{
+		{index: 10, value: "number: 1\ndocument:\n    adviser_type: CBS\n"},
+		// consul was reset, the index went backwards
+		{index: 3, value: "number: 1\ndocument:\n    adviser_type: CBS\n"},
+		{index: 3, value: "number: 2\ndocument:\n    adviser_type: CBS\n"},
+		{index: 4},
+		{index: 5, value: "document:\n    adviser_type: CBS\n"},
+	}}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logged []interface{}
+	logger := log.LoggerFunc(func(keyvals ...interface{}) error {
+		logged = append(logged, keyvals...)
+		return nil
+	})
+
+	type change struct {
+		v   *params.Version
+		err error
+	}
+	changes := make(chan change, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- NewParamsConsulWatcher(logger, client, "params/").Watch(ctx, "CBS", func(v *params.Version, err error) {
+			changes <- change{v, err}
+		})
+	}()
+
+	var got []change
+	for len(got) < 3 {
+		select {
+		case c := <-changes:
+			got = append(got, c)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected 3 changes, got %d", len(got))
+		}
+	}
+	// the watching goes on after the error
+	for deadline := time.Now().Add(5 * time.Second); stub.requests() < 6 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if got[0].err != nil || got[0].v.Number != 1 || got[0].v.Name != "CBS" {
+		t.Errorf("expected version 1, got %+v", got[0])
+	}
+	// the read after the reset starts over without an index
+	if got[1].err != nil || got[1].v.Number != 2 {
+		t.Errorf("expected version 2, got %+v", got[1])
+	}
+	// a version without a number isn't run by
+	if got[2].err == nil {
+		t.Errorf("expected an error for the params without a number, got %+v", got[2].v)
+	}
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+	expected := []string{"", "10", "", "3", "4", "5"}
+	if len(stub.indexes) != len(expected) {
+		t.Fatalf("expected the indexes %v, got %v", expected, stub.indexes)
+	}
+	for i := range expected {
+		if stub.indexes[i] != expected[i] {
+			t.Errorf("expected the indexes %v, got %v", expected, stub.indexes)
+			break
+		}
+	}
+
+	deleted := false
+	for i := 0; i+1 < len(logged); i += 2 {
+		if logged[i] == "deleted" && logged[i+1] == true {
+			deleted = true
+		}
+	}
+	if !deleted {
+		t.Errorf("expected the deleted key logged, got %v", logged)
+	}
+}
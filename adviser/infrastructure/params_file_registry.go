@@ -0,0 +1,263 @@
+package infrastructure
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+// paramsFileRegistry keeps the versions of every params name in <path>/<name>/versions/NNNNNN.yaml
+// and the promotions in <path>/<name>/promotions.yaml. It expects a single writer at a time.
+type paramsFileRegistry struct {
+	mu       sync.Mutex
+	filePath string
+}
+
+func NewParamsFileRegistry(filePath string) params.Registry {
+	return &paramsFileRegistry{
+		filePath: filePath,
+	}
+}
+
+func (r *paramsFileRegistry) AddVersion(name string, doc params.Document) (*params.Version, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	numbers, err := r.versionNumbers(name)
+	if err != nil {
+		return nil, err
+	}
+	promotions, err := r.readPromotions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &params.Version{
+		Name:     name,
+		Number:   1,
+		Document: doc,
+		AddedAt:  time.Now().UTC(),
+	}
+	if len(numbers) > 0 {
+		v.Number = numbers[len(numbers)-1] + 1
+	}
+	if stack := params.LiveStack(promotions); len(stack) > 0 {
+		v.Parent = stack[len(stack)-1]
+	}
+
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddVersion encoding failed")
+	}
+	if err := os.MkdirAll(filepath.Dir(r.versionPath(name, v.Number)), 0755); err != nil {
+		return nil, errors.Wrap(err, "AddVersion mkdir failed")
+	}
+
+	// linking a complete file never overwrites a version, and the readers never get it half-written
+	path := r.versionPath(name, v.Number)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0444); err != nil {
+		return nil, errors.Wrap(err, "AddVersion file write failed")
+	}
+	defer os.Remove(tmp)
+	if err := os.Link(tmp, path); err != nil {
+		return nil, errors.Wrap(err, "AddVersion file link failed")
+	}
+
+	return v, nil
+}
+
+func (r *paramsFileRegistry) GetVersion(name string, number int, schema advice.ParamsSchema) (*params.Version, error) {
+	v, err := r.readVersion(name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	v.Document.Params, err = schema.Validate(v.Document.Params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetVersion %s %d failed", name, number)
+	}
+
+	return v, nil
+}
+
+func (r *paramsFileRegistry) ListVersions(name string) ([]params.Version, error) {
+	numbers, err := r.versionNumbers(name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]params.Version, len(numbers))
+	for i, n := range numbers {
+		v, err := r.readVersion(name, n)
+		if err != nil {
+			return nil, err
+		}
+		versions[i] = *v
+	}
+
+	return versions, nil
+}
+
+func (r *paramsFileRegistry) GetLive(name string, schema advice.ParamsSchema) (*params.Version, error) {
+	promotions, err := r.readPromotions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	stack := params.LiveStack(promotions)
+	if len(stack) == 0 {
+		return nil, nil
+	}
+
+	return r.GetVersion(name, stack[len(stack)-1], schema)
+}
+
+func (r *paramsFileRegistry) Promote(name string, number int) (*params.Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.readVersion(name, number); err != nil {
+		return nil, err
+	}
+
+	promotions, err := r.readPromotions(name)
+	if err != nil {
+		return nil, err
+	}
+	if stack := params.LiveStack(promotions); len(stack) > 0 && stack[len(stack)-1] == number {
+		return nil, params.ErrAlreadyLive
+	}
+
+	p := params.Promotion{Version: number, PromotedAt: time.Now().UTC()}
+	if err := r.writePromotions(name, append(promotions, p)); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *paramsFileRegistry) Rollback(name string) (*params.Promotion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	promotions, err := r.readPromotions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := params.NextRollback(promotions, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.writePromotions(name, append(promotions, *p)); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *paramsFileRegistry) GetPromotions(name string) ([]params.Promotion, error) {
+	return r.readPromotions(name)
+}
+
+func (r *paramsFileRegistry) readVersion(name string, number int) (*params.Version, error) {
+	data, err := ioutil.ReadFile(r.versionPath(name, number))
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf("version %d of params %s not found", number, name)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "readVersion file read failed")
+	}
+
+	v := new(params.Version)
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return nil, errors.Wrapf(err, "readVersion %s %d decoding failed", name, number)
+	}
+
+	return v, nil
+}
+
+func (r *paramsFileRegistry) versionNumbers(name string) ([]int, error) {
+	files, err := ioutil.ReadDir(filepath.Dir(r.versionPath(name, 1)))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "versionNumbers read dir failed")
+	}
+
+	var numbers []int
+	for _, f := range files {
+		var n int
+		// the leftovers of the interrupted writes end with .tmp and don't match
+		if _, err := fmt.Sscanf(f.Name(), "%06d.yaml", &n); err == nil && f.Name() == fmt.Sprintf("%06d.yaml", n) {
+			numbers = append(numbers, n)
+		}
+	}
+	sort.Ints(numbers)
+
+	return numbers, nil
+}
+
+func (r *paramsFileRegistry) readPromotions(name string) ([]params.Promotion, error) {
+	data, err := ioutil.ReadFile(r.promotionsPath(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "readPromotions file read failed")
+	}
+
+	var promotions []params.Promotion
+	if err := yaml.Unmarshal(data, &promotions); err != nil {
+		return nil, errors.Wrapf(err, "readPromotions %s decoding failed", name)
+	}
+
+	return promotions, nil
+}
+
+// writePromotions replaces the file by renaming, so the readers never get it half-written
+func (r *paramsFileRegistry) writePromotions(name string, promotions []params.Promotion) error {
+	data, err := yaml.Marshal(promotions)
+	if err != nil {
+		return errors.Wrap(err, "writePromotions encoding failed")
+	}
+
+	path := r.promotionsPath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "writePromotions mkdir failed")
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrap(err, "writePromotions file write failed")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrap(err, "writePromotions file rename failed")
+	}
+
+	return nil
+}
+
+func (r *paramsFileRegistry) versionPath(name string, number int) string {
+	return filepath.Join(r.filePath, registryDir(name), "versions", fmt.Sprintf("%06d.yaml", number))
+}
+
+func (r *paramsFileRegistry) promotionsPath(name string) string {
+	return filepath.Join(r.filePath, registryDir(name), "promotions.yaml")
+}
+
+func registryDir(name string) string {
+	return strings.NewReplacer("=", "_", "/", "_", "..", "_").Replace(name)
+}
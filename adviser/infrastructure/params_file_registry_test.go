@@ -0,0 +1,164 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+func cbsDocument(calmDurationHours int64) params.Document {
+	p := advice.CBSParamsSchema.Defaults()
+	p["calm_duration_hours"] = decimal.NewFromInt(calmDurationHours)
+
+	return params.Document{AdviserType: advice.AdviserTypeCBS, Params: p}
+}
+
+func TestParamsFileRegistry_AddVersion(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	registry := NewParamsFileRegistry(dir)
+
+	for i := 1; i <= 3; i++ {
+		v, err := registry.AddVersion("CBS", cbsDocument(int64(10+i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Number != i || v.Parent != 0 {
+			t.Errorf("expected version %d without a parent, got %d with %d", i, v.Number, v.Parent)
+		}
+	}
+
+	// a version added while another one is live is meant to replace it
+	if _, err := registry.Promote("CBS", 2); err != nil {
+		t.Fatal(err)
+	}
+	v, err := registry.AddVersion("CBS", cbsDocument(20))
+	if err != nil || v.Number != 4 || v.Parent != 2 {
+		t.Fatal(v, err)
+	}
+
+	// the leftover of an interrupted write doesn't count as a version
+	writeParamsFile(t, registryTestPath(dir, "000005.yaml.tmp"), "garbage")
+	if v, err := registry.AddVersion("CBS", cbsDocument(21)); err != nil || v.Number != 5 {
+		t.Fatal(v, err)
+	}
+
+	versions, err := registry.ListVersions("CBS")
+	if err != nil || len(versions) != 5 {
+		t.Fatal(len(versions), err)
+	}
+	if got, err := registry.GetVersion("CBS", 3, advice.CBSParamsSchema); err != nil || got.Document.Params.Int("calm_duration_hours") != 13 {
+		t.Error(got, err)
+	}
+}
+
+func TestParamsFileRegistry_AddVersion_NoOverwrite(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	registry := NewParamsFileRegistry(dir)
+
+	if _, err := registry.AddVersion("CBS", cbsDocument(11)); err != nil {
+		t.Fatal(err)
+	}
+	path := registryTestPath(dir, "000001.yaml")
+	before, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := registry.AddVersion("CBS", cbsDocument(12)); err != nil || v.Number != 2 {
+		t.Fatal(v, err)
+	}
+	after, err := ioutil.ReadFile(path)
+	if err != nil || string(after) != string(before) {
+		t.Errorf("expected version 1 untouched, got %s %v", after, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm()&0222 != 0 {
+		t.Errorf("expected version 1 read-only, got %v %v", info.Mode(), err)
+	}
+}
+
+func TestParamsFileRegistry_PromoteRollback(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	registry := NewParamsFileRegistry(dir)
+
+	if live, err := registry.GetLive("CBS", advice.CBSParamsSchema); err != nil || live != nil {
+		t.Fatal("expected no live version before a promotion", live, err)
+	}
+	for i := 1; i <= 2; i++ {
+		if _, err := registry.AddVersion("CBS", cbsDocument(int64(10+i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := registry.Promote("CBS", 3); err == nil {
+		t.Error("expected an error for a missing version")
+	}
+
+	assertLive := func(number int, calmDurationHours int) {
+		t.Helper()
+		live, err := registry.GetLive("CBS", advice.CBSParamsSchema)
+		if err != nil || live == nil || live.Number != number || live.Document.Params.Int("calm_duration_hours") != calmDurationHours {
+			t.Fatalf("expected version %d live, got %+v %v", number, live, err)
+		}
+	}
+
+	if _, err := registry.Promote("CBS", 1); err != nil {
+		t.Fatal(err)
+	}
+	assertLive(1, 11)
+	if _, err := registry.Promote("CBS", 2); err != nil {
+		t.Fatal(err)
+	}
+	assertLive(2, 12)
+	if _, err := registry.Promote("CBS", 2); err != params.ErrAlreadyLive {
+		t.Errorf("expected ErrAlreadyLive, got %v", err)
+	}
+
+	// a registry reading the files anew gets the same history
+	registry = NewParamsFileRegistry(dir)
+	if p, err := registry.Rollback("CBS"); err != nil || p.Version != 1 || !p.Rollback {
+		t.Fatal(p, err)
+	}
+	assertLive(1, 11)
+	if _, err := registry.Rollback("CBS"); err != params.ErrNothingToRollback {
+		t.Errorf("expected ErrNothingToRollback, got %v", err)
+	}
+
+	promotions, err := registry.GetPromotions("CBS")
+	if err != nil || len(promotions) != 3 {
+		t.Fatal(promotions, err)
+	}
+
+	data, err := ioutil.ReadFile(registryTestPath(dir, "000001.yaml"))
+	if err != nil || strings.Contains(string(data), `"11"`) {
+		t.Errorf("expected the decimals of a version unquoted, got %s %v", data, err)
+	}
+}
+
+func TestParamsFileRegistry_GetLive_Invalid(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	registry := NewParamsFileRegistry(dir)
+
+	// params out of the range of the schema never run
+	if _, err := registry.AddVersion("CBS", cbsDocument(0)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Promote("CBS", 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.GetLive("CBS", advice.CBSParamsSchema); err == nil {
+		t.Error("expected the live params validated by the schema")
+	}
+}
+
+func registryTestPath(dir, file string) string {
+	return dir + "CBS/versions/" + file
+}
@@ -1,76 +1,184 @@
 package infrastructure
 
 import (
+	"bytes"
 	"encoding/csv"
-	"io"
+	"encoding/json"
+	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
 
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
 	"github.com/websmee/example_of_my_code/adviser/domain/params"
 )
 
+// ParamsFormat is the format the params are saved in, they are loaded from any of them.
+type ParamsFormat string
+
+const (
+	ParamsFormatYAML ParamsFormat = "yaml"
+	ParamsFormatJSON ParamsFormat = "json"
+	// paramsFormatCSV is the legacy format of a single row of the values in the order of the schema
+	paramsFormatCSV ParamsFormat = "csv"
+)
+
+func ParseParamsFormat(s string) (ParamsFormat, error) {
+	switch f := ParamsFormat(s); f {
+	case ParamsFormatYAML, ParamsFormatJSON:
+		return f, nil
+	default:
+		return "", errors.Errorf("unknown params format %q", s)
+	}
+}
+
 type paramsFileRepository struct {
 	filePath string
+	format   ParamsFormat
 }
 
-func NewParamsFileRepository(filePath string) params.Repository {
+func NewParamsFileRepository(filePath string, format ParamsFormat) params.Repository {
 	return &paramsFileRepository{
 		filePath: filePath,
+		format:   format,
 	}
 }
 
-func (r paramsFileRepository) SaveParams(name string, params []decimal.Decimal) error {
-	f, err := os.Create(r.getFilepath(name))
+// paramsFormatPrecedence is the order the formats are loaded in, the first file found is the params
+var paramsFormatPrecedence = []ParamsFormat{ParamsFormatYAML, ParamsFormatJSON, paramsFormatCSV}
+
+// SaveParams refuses to save the params a file of a preceding format would hide from LoadParams.
+func (r paramsFileRepository) SaveParams(name string, doc params.Document) error {
+	format, err := r.findFormat(name)
 	if err != nil {
-		return errors.Wrap(err, "SaveParams file create failed")
+		return errors.Wrap(err, "SaveParams failed")
+	}
+	if format != "" && format != r.format && precedes(format, r.format) {
+		return errors.Errorf("SaveParams failed: %s would be hidden by %s", r.getFilepath(name, r.format), r.getFilepath(name, format))
 	}
-	defer f.Close()
-
-	writer := csv.NewWriter(f)
-	defer writer.Flush()
 
-	record := make([]string, len(params))
-	for i, p := range params {
-		record[i] = p.String()
+	var data []byte
+	switch r.format {
+	case ParamsFormatJSON:
+		data, err = json.MarshalIndent(doc, "", "  ")
+	default:
+		data, err = yaml.Marshal(doc)
+	}
+	if err != nil {
+		return errors.Wrap(err, "SaveParams encoding failed")
 	}
-	if err := writer.Write(record); err != nil {
+
+	if err := ioutil.WriteFile(r.getFilepath(name, r.format), data, 0644); err != nil {
 		return errors.Wrap(err, "SaveParams file write failed")
 	}
 
 	return nil
 }
 
-func (r paramsFileRepository) LoadParams(name string) ([]decimal.Decimal, error) {
-	f, err := os.Open(r.getFilepath(name))
+// LoadParams reads the file of the name in the first format of paramsFormatPrecedence,
+// so the params saved as YAML replace the legacy ones.
+func (r paramsFileRepository) LoadParams(name string, schema advice.ParamsSchema) (*params.Document, error) {
+	format, err := r.findFormat(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "LoadParams failed")
+	}
+	if format == "" {
+		return nil, errors.Errorf("LoadParams failed: no params %s in %s", name, r.filePath)
+	}
+
+	data, err := ioutil.ReadFile(r.getFilepath(name, format))
+	if err != nil {
+		return nil, errors.Wrap(err, "LoadParams file read failed")
+	}
+
+	doc := new(params.Document)
+	switch format {
+	case ParamsFormatYAML:
+		err = yaml.Unmarshal(data, doc)
+	case ParamsFormatJSON:
+		err = json.Unmarshal(data, doc)
+	default:
+		doc.Params, err = decodeLegacyParams(data, schema)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "LoadParams "+name+" decoding failed")
+	}
+
+	doc.Params, err = schema.Validate(doc.Params)
 	if err != nil {
-		return nil, errors.Wrap(err, "LoadParams file open failed")
+		return nil, errors.Wrap(err, "LoadParams "+name+" failed")
 	}
-	defer f.Close()
 
-	{
-		reader := csv.NewReader(f)
-		record, err := reader.Read()
-		if err == io.EOF || len(record) == 0 {
-			return nil, errors.Wrap(err, "LoadParams file read failed")
+	return doc, nil
+}
+
+// findFormat returns the first format of paramsFormatPrecedence a file of the name exists in, empty if there is none
+func (r paramsFileRepository) findFormat(name string) (ParamsFormat, error) {
+	for _, f := range paramsFormatPrecedence {
+		_, err := os.Stat(r.getFilepath(name, f))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
 		}
+		return f, nil
+	}
 
-		var ps []decimal.Decimal
-		for i := range record {
-			p, err := decimal.NewFromString(record[i])
-			if err != nil {
-				return nil, errors.Wrap(err, "LoadParams param parsing failed")
-			}
-			ps = append(ps, p)
+	return "", nil
+}
+
+func precedes(a, b ParamsFormat) bool {
+	for _, f := range paramsFormatPrecedence {
+		switch f {
+		case a:
+			return true
+		case b:
+			return false
 		}
+	}
+
+	return false
+}
 
-		return ps, nil
+// decodeLegacyParams reads the values in the order of the schema, or by the names of a header row.
+func decodeLegacyParams(data []byte, schema advice.ParamsSchema) (advice.Params, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || len(records) > 2 {
+		return nil, errors.Errorf("%d rows", len(records))
+	}
+
+	values := make([]decimal.Decimal, len(records[len(records)-1]))
+	for i, v := range records[len(records)-1] {
+		values[i], err = decimal.NewFromString(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
 	}
+	if len(records) == 1 {
+		return schema.FromValues(values)
+	}
+
+	if len(records[0]) != len(values) {
+		return nil, errors.Errorf("%d names, %d values", len(records[0]), len(values))
+	}
+	p := make(advice.Params, len(values))
+	for i := range values {
+		p[strings.TrimSpace(records[0][i])] = values[i]
+	}
+
+	return p, nil
 }
 
-func (r paramsFileRepository) getFilepath(name string) string {
+func (r paramsFileRepository) getFilepath(name string, format ParamsFormat) string {
 	//todo: normalize filename
-	return r.filePath + strings.ReplaceAll(name, "=", "_") + ".csv"
+	return r.filePath + strings.ReplaceAll(name, "=", "_") + "." + string(format)
 }
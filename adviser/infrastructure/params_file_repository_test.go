@@ -0,0 +1,128 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/advice"
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+func newTempDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "params")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir + string(filepath.Separator), func() { _ = os.RemoveAll(dir) }
+}
+
+func writeParamsFile(t *testing.T, path, content string) {
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParamsFileRepository_LoadParams_LegacyCSV(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	repo := NewParamsFileRepository(dir, ParamsFormatYAML)
+
+	// the values in the order of the schema
+	writeParamsFile(t, dir+"POSITIONAL.csv", "12,2,0.5,4,3,8,0,1,1,24,0\n")
+	doc, err := repo.LoadParams("POSITIONAL", advice.CBSParamsSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Params.Int("calm_duration_hours") != 12 || !doc.Params.Decimal("calm_max_curvature").Equal(decimal.RequireFromString("0.5")) {
+		t.Error(doc.Params)
+	}
+
+	// the values by the names of a header row, the missing ones by their defaults
+	writeParamsFile(t, dir+"NAMED.csv", "storm_duration_hours, take_profit_diff\n6, 1.5\n")
+	doc, err = repo.LoadParams("NAMED", advice.CBSParamsSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.Params.Int("storm_duration_hours") != 6 || doc.Params.Int("calm_duration_hours") != 24 ||
+		!doc.Params.Decimal("take_profit_diff").Equal(decimal.RequireFromString("1.5")) {
+		t.Error(doc.Params)
+	}
+
+	writeParamsFile(t, dir+"SHORT.csv", "12,2\n")
+	if _, err := repo.LoadParams("SHORT", advice.CBSParamsSchema); err == nil {
+		t.Error("expected an error for the values missing from a positional file")
+	}
+}
+
+func TestParamsFileRepository_RoundTrip(t *testing.T) {
+	for _, format := range []ParamsFormat{ParamsFormatYAML, ParamsFormatJSON} {
+		dir, cleanup := newTempDir(t)
+		repo := NewParamsFileRepository(dir, format)
+
+		saved := params.Document{
+			AdviserType: advice.AdviserTypeCBS,
+			Params:      advice.CBSParamsSchema.Defaults(),
+			Period: &params.Period{
+				From: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Scores:    map[string]float64{params.ScoreAccuracy: 61.5},
+			Symbols:   []string{"AAPL", "MSFT"},
+			CreatedAt: time.Date(2021, 3, 2, 10, 0, 0, 0, time.UTC),
+		}
+		saved.Params["calm_max_change"] = decimal.RequireFromString("0.35")
+		if err := repo.SaveParams("CBS", saved); err != nil {
+			t.Fatal(format, err)
+		}
+
+		data, err := ioutil.ReadFile(dir + "CBS." + string(format))
+		if err != nil {
+			t.Fatal(format, err)
+		}
+		if strings.Contains(string(data), `"0.35"`) || !strings.Contains(string(data), "0.35") {
+			t.Errorf("%s: expected the decimals unquoted, got\n%s", format, data)
+		}
+
+		loaded, err := repo.LoadParams("CBS", advice.CBSParamsSchema)
+		if err != nil {
+			t.Fatal(format, err)
+		}
+		if loaded.AdviserType != saved.AdviserType || loaded.Params.String() != saved.Params.String() ||
+			!loaded.Period.From.Equal(saved.Period.From) || !loaded.Period.To.Equal(saved.Period.To) ||
+			loaded.Scores[params.ScoreAccuracy] != 61.5 || len(loaded.Symbols) != 2 || !loaded.CreatedAt.Equal(saved.CreatedAt) {
+			t.Errorf("%s: expected %+v, got %+v", format, saved, loaded)
+		}
+
+		cleanup()
+	}
+}
+
+func TestParamsFileRepository_Precedence(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+
+	writeParamsFile(t, dir+"CBS.csv", "12,2,0.5,4,3,8,0,1,1,24,0\n")
+	writeParamsFile(t, dir+"CBS.json", `{"adviser_type": "CBS", "params": {"calm_duration_hours": 13}}`)
+	writeParamsFile(t, dir+"CBS.yaml", "adviser_type: CBS\nparams:\n    calm_duration_hours: 14\n")
+	// the older YAML file still precedes the newer legacy one
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(dir+"CBS.yaml", old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := NewParamsFileRepository(dir, ParamsFormatYAML).LoadParams("CBS", advice.CBSParamsSchema)
+	if err != nil || doc.Params.Int("calm_duration_hours") != 14 {
+		t.Fatal(doc, err)
+	}
+
+	if err := NewParamsFileRepository(dir, ParamsFormatJSON).SaveParams("CBS", params.Document{}); err == nil {
+		t.Error("expected an error for the JSON params hidden by the YAML ones")
+	}
+}
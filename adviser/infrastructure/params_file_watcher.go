@@ -0,0 +1,65 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+// paramsFileWatcher polls the promotions of the file registry. The registry renames the files into place,
+// so a poll never reads a half-written one.
+type paramsFileWatcher struct {
+	registry *paramsFileRegistry
+	interval time.Duration
+}
+
+func NewParamsFileWatcher(filePath string, interval time.Duration) params.Watcher {
+	return &paramsFileWatcher{
+		registry: &paramsFileRegistry{filePath: filePath},
+		interval: interval,
+	}
+}
+
+func (r *paramsFileWatcher) Watch(ctx context.Context, name string, changed func(v *params.Version, err error)) error {
+	live := 0
+	failed := ""
+	for {
+		v, err := r.readLive(name, live)
+		switch {
+		case err != nil:
+			// the same failure is reported once, not every poll
+			if err.Error() != failed {
+				failed = err.Error()
+				changed(nil, err)
+			}
+		case v != nil:
+			failed = ""
+			live = v.Number
+			changed(v, nil)
+		default:
+			failed = ""
+		}
+
+		select {
+		case <-time.After(r.interval):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// readLive returns the live version if it isn't the known one, nil otherwise
+func (r *paramsFileWatcher) readLive(name string, known int) (*params.Version, error) {
+	promotions, err := r.registry.readPromotions(name)
+	if err != nil {
+		return nil, err
+	}
+
+	stack := params.LiveStack(promotions)
+	if len(stack) == 0 || stack[len(stack)-1] == known {
+		return nil, nil
+	}
+
+	return r.registry.readVersion(name, stack[len(stack)-1])
+}
@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/adviser/domain/params"
+)
+
+func TestParamsFileWatcher_Watch(t *testing.T) {
+	dir, cleanup := newTempDir(t)
+	defer cleanup()
+	registry := NewParamsFileRegistry(dir)
+	for i := 1; i <= 2; i++ {
+		if _, err := registry.AddVersion("CBS", cbsDocument(int64(10+i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := registry.Promote("CBS", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	type change struct {
+		v   *params.Version
+		err error
+	}
+	changes := make(chan change, 100)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = NewParamsFileWatcher(dir, time.Millisecond).Watch(ctx, "CBS", func(v *params.Version, err error) {
+			changes <- change{v, err}
+		})
+	}()
+	next := func() change {
+		t.Helper()
+		select {
+		case c := <-changes:
+			return c
+		case <-time.After(5 * time.Second):
+			t.Fatal("no change")
+			return change{}
+		}
+	}
+	settle := func() {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if c := next(); c.err != nil || c.v.Number != 1 {
+		t.Fatalf("expected version 1, got %+v", c)
+	}
+
+	// the broken promotions are reported once, however many polls read them
+	promotions := filepath.Join(dir, "CBS", "promotions.yaml")
+	if err := os.Rename(promotions, promotions+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	writeParamsFile(t, promotions, "{broken")
+	if c := next(); c.err == nil {
+		t.Fatalf("expected an error, got %+v", c.v)
+	}
+	settle()
+	if len(changes) != 0 {
+		t.Errorf("expected the failure reported once, got %d more changes", len(changes))
+	}
+
+	// the same version once the promotions are repaired isn't a change
+	if err := os.Rename(promotions+".bak", promotions); err != nil {
+		t.Fatal(err)
+	}
+	settle()
+	if len(changes) != 0 {
+		t.Errorf("expected no change for the same live version, got %+v", <-changes)
+	}
+
+	if _, err := registry.Promote("CBS", 2); err != nil {
+		t.Fatal(err)
+	}
+	if c := next(); c.err != nil || c.v.Number != 2 {
+		t.Fatalf("expected version 2, got %+v", c)
+	}
+}
@@ -0,0 +1,25 @@
+package calendar
+
+import (
+	"time"
+)
+
+type alwaysOpenCalendar struct{}
+
+// NewAlwaysOpenCalendar is for 24/7 markets, every UTC day is a session.
+func NewAlwaysOpenCalendar() Calendar {
+	return &alwaysOpenCalendar{}
+}
+
+func (r alwaysOpenCalendar) GetSessions(from, to time.Time) []Session {
+	var sessions []Session
+	for day := startOfDay(from.UTC()); day.Before(to); day = day.AddDate(0, 0, 1) {
+		sessions = append(sessions, Session{Open: day, Close: day.AddDate(0, 0, 1)})
+	}
+
+	return sessions
+}
+
+func (r alwaysOpenCalendar) Location() *time.Location {
+	return time.UTC
+}
@@ -0,0 +1,122 @@
+package calendar
+
+import (
+	"time"
+)
+
+// maxWidenings limits the search for bars in markets closed for a long time
+const maxWidenings = 6
+
+// Bar is the period of a candlestick, End isn't included. Intraday bars are cut by the session close,
+// a day bar is a whole session and a week bar covers the sessions from monday to friday.
+type Bar struct {
+	Start, End time.Time
+}
+
+// GetBars returns the bars starting within [from, to) ordered by time.
+func GetBars(c Calendar, interval time.Duration, from, to time.Time) []Bar {
+	var bars []Bar
+	switch {
+	case interval >= 7*24*time.Hour:
+		bars = getWeekBars(c.GetSessions(from.AddDate(0, 0, -7), to.AddDate(0, 0, 7)))
+	case interval >= 24*time.Hour:
+		for _, s := range c.GetSessions(from, to) {
+			bars = append(bars, Bar{Start: s.Open, End: s.Close})
+		}
+	default:
+		for _, s := range c.GetSessions(from, to) {
+			for start := s.Open; start.Before(s.Close); start = start.Add(interval) {
+				end := start.Add(interval)
+				if end.After(s.Close) {
+					end = s.Close
+				}
+				bars = append(bars, Bar{Start: start, End: end})
+			}
+		}
+	}
+
+	result := bars[:0]
+	for i := range bars {
+		if !bars[i].Start.Before(from) && bars[i].Start.Before(to) {
+			result = append(result, bars[i])
+		}
+	}
+
+	return result
+}
+
+// BarsBefore returns up to count last bars starting not later than t.
+func BarsBefore(c Calendar, interval time.Duration, t time.Time, count int) []Bar {
+	if count <= 0 {
+		return nil
+	}
+
+	var bars []Bar
+	window := searchWindow(interval, count)
+	for i := 0; i < maxWidenings && len(bars) < count; i, window = i+1, window*2 {
+		bars = GetBars(c, interval, t.Add(-window), t.Add(time.Nanosecond))
+	}
+	if len(bars) > count {
+		bars = bars[len(bars)-count:]
+	}
+
+	return bars
+}
+
+// BarsAfter returns up to count first bars starting not earlier than t.
+func BarsAfter(c Calendar, interval time.Duration, t time.Time, count int) []Bar {
+	if count <= 0 {
+		return nil
+	}
+
+	var bars []Bar
+	window := searchWindow(interval, count)
+	for i := 0; i < maxWidenings && len(bars) < count; i, window = i+1, window*2 {
+		bars = GetBars(c, interval, t, t.Add(window))
+	}
+	if len(bars) > count {
+		bars = bars[:count]
+	}
+
+	return bars
+}
+
+// LastClosedBar returns the latest bar ended by now.
+func LastClosedBar(c Calendar, interval time.Duration, now time.Time) (Bar, bool) {
+	bars := BarsBefore(c, interval, now, 2)
+	for i := len(bars) - 1; i >= 0; i-- {
+		if !bars[i].End.After(now) {
+			return bars[i], true
+		}
+	}
+
+	return Bar{}, false
+}
+
+// searchWindow is wide enough for count bars of a regular weekday market with a few holidays
+func searchWindow(interval time.Duration, count int) time.Duration {
+	span := time.Duration(count) * interval
+	if interval < 24*time.Hour {
+		// a session of 8 hours takes a whole day
+		span *= 3
+	}
+
+	return span*7/5 + 7*24*time.Hour
+}
+
+func getWeekBars(sessions []Session) []Bar {
+	var bars []Bar
+	var week time.Time
+	for i := range sessions {
+		day := startOfDay(sessions[i].Open)
+		monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		if len(bars) == 0 || !monday.Equal(week) {
+			week = monday
+			bars = append(bars, Bar{Start: sessions[i].Open, End: sessions[i].Close})
+			continue
+		}
+		bars[len(bars)-1].End = sessions[i].Close
+	}
+
+	return bars
+}
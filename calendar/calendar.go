@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Session is a continuous trading period, Close isn't included.
+type Session struct {
+	Open, Close time.Time
+}
+
+type Calendar interface {
+	// GetSessions returns the sessions overlapping [from, to) ordered by time, they aren't cut to fit.
+	GetSessions(from, to time.Time) []Session
+	// Location is the one the sessions are local to, the trading days and weeks are cut by it.
+	Location() *time.Location
+}
+
+// ForMarket picks the calendar by the exchange, the quotes without metadata trade by the NYSE one.
+func ForMarket(exchange, timezone, tradingHours string) (Calendar, error) {
+	if tradingHours == TradingHoursAlways {
+		return NewAlwaysOpenCalendar(), nil
+	}
+
+	switch exchange {
+	case ExchangeNYSE, ExchangeNASDAQ, "":
+		return NewNYSECalendar(), nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.Wrap(err, "timezone")
+	}
+
+	open, close, err := ParseTradingHours(tradingHours)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWeekdayCalendar(location, open, close), nil
+}
+
+// Calendars maps symbols to their calendars, unknown symbols trade by the NYSE one.
+type Calendars map[string]Calendar
+
+func (r Calendars) Get(symbol string) Calendar {
+	if c, ok := r[symbol]; ok {
+		return c
+	}
+
+	return NewNYSECalendar()
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,123 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNYSECalendar_Holidays(t *testing.T) {
+	c := NewNYSECalendar()
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, nyseLocation)
+	to := time.Date(2022, 1, 1, 0, 0, 0, 0, nyseLocation)
+
+	closed := map[string]bool{
+		"2021-01-01": true,
+		"2021-01-18": true,
+		"2021-02-15": true,
+		"2021-04-02": true,
+		"2021-05-31": true,
+		"2021-07-05": true,
+		"2021-09-06": true,
+		"2021-11-25": true,
+		"2021-12-24": true,
+	}
+
+	sessions := c.GetSessions(from, to)
+	if len(sessions) != 252 {
+		t.Error("sessions", len(sessions))
+	}
+	for _, s := range sessions {
+		if closed[s.Open.Format("2006-01-02")] {
+			t.Error("holiday", s.Open)
+		}
+	}
+}
+
+func TestNYSECalendar_EarlyClose(t *testing.T) {
+	c := NewNYSECalendar()
+	day := time.Date(2021, 11, 26, 0, 0, 0, 0, nyseLocation)
+
+	sessions := c.GetSessions(day, day.AddDate(0, 0, 1))
+	if len(sessions) != 1 {
+		t.Fatal(sessions)
+	}
+	if !sessions[0].Close.Equal(time.Date(2021, 11, 26, 13, 0, 0, 0, nyseLocation)) {
+		t.Error(sessions[0].Close)
+	}
+
+	bars := GetBars(c, time.Hour, day, day.AddDate(0, 0, 1))
+	if len(bars) != 4 {
+		t.Fatal(bars)
+	}
+	if !bars[3].End.Equal(sessions[0].Close) {
+		t.Error(bars[3])
+	}
+}
+
+func TestLastClosedBar(t *testing.T) {
+	// saturday after the friday session closing at 16:00
+	now := time.Date(2021, 11, 20, 12, 0, 0, 0, nyseLocation)
+
+	bar, ok := LastClosedBar(NewNYSECalendar(), time.Hour, now)
+	if !ok {
+		t.Fatal(ok)
+	}
+	if !bar.Start.Equal(time.Date(2021, 11, 19, 15, 30, 0, 0, nyseLocation)) ||
+		!bar.End.Equal(time.Date(2021, 11, 19, 16, 0, 0, 0, nyseLocation)) {
+		t.Error(bar)
+	}
+
+	bar, ok = LastClosedBar(NewAlwaysOpenCalendar(), time.Hour, now)
+	if !ok {
+		t.Fatal(ok)
+	}
+	if !bar.End.Equal(now.Truncate(time.Hour)) {
+		t.Error(bar)
+	}
+}
+
+func TestBarsBefore(t *testing.T) {
+	// monday after thanksgiving week
+	start := time.Date(2021, 11, 29, 10, 0, 0, 0, nyseLocation)
+
+	bars := BarsBefore(NewNYSECalendar(), 24*time.Hour, start, 5)
+	if len(bars) != 5 {
+		t.Fatal(bars)
+	}
+
+	expected := []int{22, 23, 24, 26, 29}
+	for i := range bars {
+		if bars[i].Start.In(nyseLocation).Day() != expected[i] {
+			t.Error(i, bars[i])
+		}
+	}
+}
+
+func TestForMarket(t *testing.T) {
+	if _, ok := mustForMarket(t, "", "", TradingHoursAlways).(*alwaysOpenCalendar); !ok {
+		t.Error("always")
+	}
+
+	c := mustForMarket(t, "LSE", "Europe/London", "08:00-16:30")
+	day := time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC)
+	sessions := c.GetSessions(day, day.AddDate(0, 0, 1))
+	if len(sessions) != 1 || sessions[0].Open.Hour() != 8 || sessions[0].Close.Minute() != 30 {
+		t.Error(sessions)
+	}
+
+	if _, err := ForMarket("LSE", "Europe/London", "8-16"); err == nil {
+		t.Error("invalid trading hours")
+	}
+	if _, err := ForMarket("LSE", "Europe/Nowhere", "08:00-16:30"); err == nil {
+		t.Error("invalid timezone")
+	}
+}
+
+func mustForMarket(t *testing.T, exchange, timezone, tradingHours string) Calendar {
+	c, err := ForMarket(exchange, timezone, tradingHours)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
@@ -0,0 +1,98 @@
+package calendar
+
+import (
+	"sync"
+	"time"
+)
+
+// date is a calendar day independent of time zones
+type date struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) date {
+	y, m, d := t.Date()
+	return date{y, m, d}
+}
+
+// holidayRules returns the closed days and the early closes of a year
+type holidayRules func(year int, location *time.Location) (holidays map[date]bool, earlyCloses map[date]time.Duration)
+
+type exchangeCalendar struct {
+	location *time.Location
+	open     time.Duration
+	close    time.Duration
+	rules    holidayRules
+
+	lock        sync.Mutex
+	holidays    map[int]map[date]bool
+	earlyCloses map[int]map[date]time.Duration
+}
+
+// NewWeekdayCalendar trades every weekday from open to close of the location's local time, with no holidays.
+func NewWeekdayCalendar(location *time.Location, open, close time.Duration) Calendar {
+	return newExchangeCalendar(location, open, close, nil)
+}
+
+func newExchangeCalendar(location *time.Location, open, close time.Duration, rules holidayRules) *exchangeCalendar {
+	return &exchangeCalendar{
+		location:    location,
+		open:        open,
+		close:       close,
+		rules:       rules,
+		holidays:    make(map[int]map[date]bool),
+		earlyCloses: make(map[int]map[date]time.Duration),
+	}
+}
+
+func (r *exchangeCalendar) GetSessions(from, to time.Time) []Session {
+	var sessions []Session
+	for day := startOfDay(from.In(r.location)).AddDate(0, 0, -1); day.Before(to); day = day.AddDate(0, 0, 1) {
+		session, ok := r.getSession(day)
+		if ok && session.Close.After(from) && session.Open.Before(to) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
+
+func (r *exchangeCalendar) Location() *time.Location {
+	return r.location
+}
+
+func (r *exchangeCalendar) getSession(day time.Time) (Session, bool) {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return Session{}, false
+	}
+
+	holidays, earlyCloses := r.getYear(day.Year())
+	if holidays[dateOf(day)] {
+		return Session{}, false
+	}
+
+	close := r.close
+	if earlyClose, ok := earlyCloses[dateOf(day)]; ok {
+		close = earlyClose
+	}
+
+	// adding durations to the local midnight keeps DST days right for the usual daytime sessions
+	return Session{Open: day.Add(r.open), Close: day.Add(close)}, true
+}
+
+func (r *exchangeCalendar) getYear(year int) (map[date]bool, map[date]time.Duration) {
+	if r.rules == nil {
+		return nil, nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.holidays[year]; !ok {
+		r.holidays[year], r.earlyCloses[year] = r.rules(year, r.location)
+	}
+
+	return r.holidays[year], r.earlyCloses[year]
+}
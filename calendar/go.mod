@@ -0,0 +1,5 @@
+module github.com/websmee/example_of_my_code/calendar
+
+go 1.15
+
+require github.com/pkg/errors v0.9.1
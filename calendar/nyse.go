@@ -0,0 +1,129 @@
+package calendar
+
+import (
+	"time"
+)
+
+const (
+	ExchangeNYSE   = "NYSE"
+	ExchangeNASDAQ = "NASDAQ"
+)
+
+var (
+	nyseLocation = mustLoadLocation("America/New_York")
+	nyseCalendar = newExchangeCalendar(nyseLocation, 9*time.Hour+30*time.Minute, 16*time.Hour, nyseRules)
+
+	// nyseSpecialClosures are the unscheduled closures, like national days of mourning
+	nyseSpecialClosures = [][3]int{
+		{2012, 10, 29}, {2012, 10, 30}, // hurricane Sandy
+		{2018, 12, 5}, // George H.W. Bush
+		{2025, 1, 9},  // Jimmy Carter
+	}
+)
+
+// NewNYSECalendar returns the regular session, holidays and early closes of NYSE, NASDAQ follows the same calendar.
+func NewNYSECalendar() Calendar {
+	return nyseCalendar
+}
+
+func nyseRules(year int, location *time.Location) (map[date]bool, map[date]time.Duration) {
+	day := func(m time.Month, d int) time.Time {
+		return time.Date(year, m, d, 0, 0, 0, 0, location)
+	}
+
+	holidays := make(map[date]bool)
+	for _, holiday := range []time.Time{
+		nthWeekday(year, time.January, time.Monday, 3, location),    // Martin Luther King Jr. Day
+		nthWeekday(year, time.February, time.Monday, 3, location),   // Washington's Birthday
+		easter(year, location).AddDate(0, 0, -2),                    // Good Friday
+		lastWeekday(year, time.May, time.Monday, location),          // Memorial Day
+		observed(day(time.July, 4)),                                 // Independence Day
+		nthWeekday(year, time.September, time.Monday, 1, location),  // Labor Day
+		nthWeekday(year, time.November, time.Thursday, 4, location), // Thanksgiving Day
+		observed(day(time.December, 25)),                            // Christmas Day
+	} {
+		holidays[dateOf(holiday)] = true
+	}
+
+	// New Year's Day falling on saturday isn't observed on the last day of the previous year
+	if newYear := day(time.January, 1); newYear.Weekday() != time.Saturday {
+		holidays[dateOf(observed(newYear))] = true
+	}
+	if year >= 2022 {
+		holidays[dateOf(observed(day(time.June, 19)))] = true // Juneteenth
+	}
+	for _, d := range nyseSpecialClosures {
+		if d[0] == year {
+			holidays[date{year, time.Month(d[1]), d[2]}] = true
+		}
+	}
+
+	earlyCloses := make(map[date]time.Duration)
+	for _, earlyCloseDay := range []time.Time{
+		day(time.July, 3),
+		nthWeekday(year, time.November, time.Thursday, 4, location).AddDate(0, 0, 1),
+		day(time.December, 24),
+	} {
+		weekend := earlyCloseDay.Weekday() == time.Saturday || earlyCloseDay.Weekday() == time.Sunday
+		if !weekend && !holidays[dateOf(earlyCloseDay)] {
+			earlyCloses[dateOf(earlyCloseDay)] = 13 * time.Hour
+		}
+	}
+
+	return holidays, earlyCloses
+}
+
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return location
+}
+
+// observed moves a holiday falling on a weekend to the closest weekday
+func observed(day time.Time) time.Time {
+	switch day.Weekday() {
+	case time.Saturday:
+		return day.AddDate(0, 0, -1)
+	case time.Sunday:
+		return day.AddDate(0, 0, 1)
+	}
+
+	return day
+}
+
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int, location *time.Location) time.Time {
+	day := time.Date(year, month, 1, 0, 0, 0, 0, location)
+	offset := (int(weekday) - int(day.Weekday()) + 7) % 7
+
+	return day.AddDate(0, 0, offset+7*(n-1))
+}
+
+func lastWeekday(year int, month time.Month, weekday time.Weekday, location *time.Location) time.Time {
+	day := time.Date(year, month+1, 0, 0, 0, 0, 0, location)
+	offset := (int(day.Weekday()) - int(weekday) + 7) % 7
+
+	return day.AddDate(0, 0, -offset)
+}
+
+// easter uses the anonymous gregorian algorithm
+func easter(year int, location *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, location)
+}
@@ -0,0 +1,31 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TradingHoursAlways marks instruments trading around the clock, like crypto.
+const TradingHoursAlways = "24/7"
+
+// ParseTradingHours parses hours like "09:30-16:00" into offsets from the local midnight.
+func ParseTradingHours(hours string) (open, close time.Duration, err error) {
+	if hours == TradingHoursAlways {
+		return 0, 24 * time.Hour, nil
+	}
+
+	var oh, om, ch, cm int
+	if _, err := fmt.Sscanf(hours, "%d:%d-%d:%d", &oh, &om, &ch, &cm); err != nil {
+		return 0, 0, errors.Errorf("invalid trading hours %q", hours)
+	}
+
+	open = time.Duration(oh)*time.Hour + time.Duration(om)*time.Minute
+	close = time.Duration(ch)*time.Hour + time.Duration(cm)*time.Minute
+	if open < 0 || close > 24*time.Hour || open >= close {
+		return 0, 0, errors.Errorf("invalid trading hours %q", hours)
+	}
+
+	return open, close, nil
+}
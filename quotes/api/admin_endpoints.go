@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+
+	"github.com/websmee/example_of_my_code/quotes/app"
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type QuotesAdmin struct {
+	CreateQuoteEndpoint  endpoint.Endpoint
+	RenameQuoteEndpoint  endpoint.Endpoint
+	UpdateQuoteEndpoint  endpoint.Endpoint
+	SuspendQuoteEndpoint endpoint.Endpoint
+	ResumeQuoteEndpoint  endpoint.Endpoint
+	DeleteQuoteEndpoint  endpoint.Endpoint
+	LoadQuoteEndpoint    endpoint.Endpoint
+	ListJobsEndpoint     endpoint.Endpoint
+	BackfillEndpoint     endpoint.Endpoint
+}
+
+func NewQuotesAdmin(svc app.QuoteManagerApp, ingestion app.IngestionApp, logger log.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) QuotesAdmin {
+	wrap := func(e endpoint.Endpoint, method string) endpoint.Endpoint {
+		e = opentracing.TraceServer(otTracer, method)(e)
+		if zipkinTracer != nil {
+			e = zipkin.TraceEndpoint(zipkinTracer, method)(e)
+		}
+		e = LoggingMiddleware(log.With(logger, "method", method))(e)
+		e = InstrumentingMiddleware(duration.With("method", method))(e)
+		return e
+	}
+
+	return QuotesAdmin{
+		CreateQuoteEndpoint:  wrap(MakeCreateQuoteEndpoint(svc), "CreateQuote"),
+		RenameQuoteEndpoint:  wrap(MakeRenameQuoteEndpoint(svc), "RenameQuote"),
+		UpdateQuoteEndpoint:  wrap(MakeUpdateQuoteEndpoint(svc), "UpdateQuoteMetadata"),
+		SuspendQuoteEndpoint: wrap(MakeQuoteSymbolEndpoint(svc.SuspendQuote), "SuspendQuote"),
+		ResumeQuoteEndpoint:  wrap(MakeQuoteSymbolEndpoint(svc.ResumeQuote), "ResumeQuote"),
+		DeleteQuoteEndpoint:  wrap(MakeQuoteSymbolEndpoint(svc.DeleteQuote), "DeleteQuote"),
+		LoadQuoteEndpoint:    wrap(MakeQuoteSymbolEndpoint(svc.LoadQuote), "LoadQuote"),
+		ListJobsEndpoint:     wrap(MakeListJobsEndpoint(ingestion), "ListJobs"),
+		BackfillEndpoint:     wrap(MakeBackfillEndpoint(ingestion), "BackfillQuote"),
+	}
+}
+
+func MakeCreateQuoteEndpoint(s app.QuoteManagerApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateQuoteRequest)
+		q, err := s.CreateQuote(req.Quote)
+		return QuoteResponse{Quote: q, Err: err}, nil
+	}
+}
+
+func MakeRenameQuoteEndpoint(s app.QuoteManagerApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(RenameQuoteRequest)
+		q, err := s.RenameQuote(req.Symbol, req.NewSymbol, req.NewName)
+		return QuoteResponse{Quote: q, Err: err}, nil
+	}
+}
+
+func MakeUpdateQuoteEndpoint(s app.QuoteManagerApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateQuoteRequest)
+		q, err := s.UpdateQuoteMetadata(req.Symbol, req.Metadata)
+		return QuoteResponse{Quote: q, Err: err}, nil
+	}
+}
+
+// MakeQuoteSymbolEndpoint serves the methods that take nothing but a symbol
+func MakeQuoteSymbolEndpoint(f func(symbol string) (*quote.Quote, error)) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(QuoteSymbolRequest)
+		q, err := f(req.Symbol)
+		return QuoteResponse{Quote: q, Err: err}, nil
+	}
+}
+
+func MakeListJobsEndpoint(s app.IngestionApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListJobsRequest)
+		jobs, err := s.ListJobs(req.Filter)
+		return ListJobsResponse{Jobs: jobs, Err: err}, nil
+	}
+}
+
+func MakeBackfillEndpoint(s app.IngestionApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(BackfillRequest)
+		j, err := s.ScheduleBackfill(req.Symbol, req.From, req.To)
+		return JobResponse{Job: j, Err: err}, nil
+	}
+}
+
+var (
+	_ endpoint.Failer = QuoteResponse{}
+	_ endpoint.Failer = ListJobsResponse{}
+	_ endpoint.Failer = JobResponse{}
+)
+
+type CreateQuoteRequest struct {
+	Quote quote.Quote
+}
+
+type RenameQuoteRequest struct {
+	Symbol    string
+	NewSymbol string
+	NewName   string
+}
+
+type UpdateQuoteRequest struct {
+	Symbol   string
+	Metadata quote.Quote
+}
+
+type QuoteSymbolRequest struct {
+	Symbol string
+}
+
+type QuoteResponse struct {
+	Quote *quote.Quote
+	Err   error
+}
+
+func (r QuoteResponse) Failed() error { return r.Err }
+
+type ListJobsRequest struct {
+	Filter app.JobFilter
+}
+
+type ListJobsResponse struct {
+	Jobs []job.Job
+	Err  error
+}
+
+func (r ListJobsResponse) Failed() error { return r.Err }
+
+type BackfillRequest struct {
+	Symbol   string
+	From, To time.Time
+}
+
+type JobResponse struct {
+	Job *job.Job
+	Err error
+}
+
+func (r JobResponse) Failed() error { return r.Err }
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+	"github.com/websmee/example_of_my_code/quotes/app"
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type adminGRPCServer struct {
+	proto.UnimplementedQuotesAdminServer
+	createQuote  grpctransport.Handler
+	renameQuote  grpctransport.Handler
+	updateQuote  grpctransport.Handler
+	suspendQuote grpctransport.Handler
+	resumeQuote  grpctransport.Handler
+	deleteQuote  grpctransport.Handler
+	loadQuote    grpctransport.Handler
+	listJobs     grpctransport.Handler
+	backfill     grpctransport.Handler
+}
+
+func NewAdminGRPCServer(endpoints QuotesAdmin, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) proto.QuotesAdminServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer))
+	}
+
+	return &adminGRPCServer{
+		createQuote: grpctransport.NewServer(
+			endpoints.CreateQuoteEndpoint,
+			decodeGRPCCreateQuoteRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "CreateQuote", logger)))...,
+		),
+		renameQuote: grpctransport.NewServer(
+			endpoints.RenameQuoteEndpoint,
+			decodeGRPCRenameQuoteRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "RenameQuote", logger)))...,
+		),
+		updateQuote: grpctransport.NewServer(
+			endpoints.UpdateQuoteEndpoint,
+			decodeGRPCUpdateQuoteMetadataRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "UpdateQuoteMetadata", logger)))...,
+		),
+		suspendQuote: grpctransport.NewServer(
+			endpoints.SuspendQuoteEndpoint,
+			decodeGRPCQuoteSymbolRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "SuspendQuote", logger)))...,
+		),
+		resumeQuote: grpctransport.NewServer(
+			endpoints.ResumeQuoteEndpoint,
+			decodeGRPCQuoteSymbolRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "ResumeQuote", logger)))...,
+		),
+		deleteQuote: grpctransport.NewServer(
+			endpoints.DeleteQuoteEndpoint,
+			decodeGRPCQuoteSymbolRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "DeleteQuote", logger)))...,
+		),
+		loadQuote: grpctransport.NewServer(
+			endpoints.LoadQuoteEndpoint,
+			decodeGRPCQuoteSymbolRequest,
+			encodeGRPCQuoteResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "LoadQuote", logger)))...,
+		),
+		listJobs: grpctransport.NewServer(
+			endpoints.ListJobsEndpoint,
+			decodeGRPCListJobsRequest,
+			encodeGRPCListJobsResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "ListJobs", logger)))...,
+		),
+		backfill: grpctransport.NewServer(
+			endpoints.BackfillEndpoint,
+			decodeGRPCBackfillQuoteRequest,
+			encodeGRPCJobResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "BackfillQuote", logger)))...,
+		),
+	}
+}
+
+func (s *adminGRPCServer) CreateQuote(ctx context.Context, req *proto.CreateQuoteRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.createQuote, req)
+}
+
+func (s *adminGRPCServer) RenameQuote(ctx context.Context, req *proto.RenameQuoteRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.renameQuote, req)
+}
+
+func (s *adminGRPCServer) UpdateQuoteMetadata(ctx context.Context, req *proto.UpdateQuoteMetadataRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.updateQuote, req)
+}
+
+func (s *adminGRPCServer) SuspendQuote(ctx context.Context, req *proto.QuoteSymbolRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.suspendQuote, req)
+}
+
+func (s *adminGRPCServer) ResumeQuote(ctx context.Context, req *proto.QuoteSymbolRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.resumeQuote, req)
+}
+
+func (s *adminGRPCServer) DeleteQuote(ctx context.Context, req *proto.QuoteSymbolRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.deleteQuote, req)
+}
+
+func (s *adminGRPCServer) LoadQuote(ctx context.Context, req *proto.QuoteSymbolRequest) (*proto.QuoteReply, error) {
+	return serveQuoteReply(ctx, s.loadQuote, req)
+}
+
+func (s *adminGRPCServer) ListJobs(ctx context.Context, req *proto.ListJobsRequest) (*proto.ListJobsReply, error) {
+	_, rep, err := s.listJobs.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.ListJobsReply), nil
+}
+
+func (s *adminGRPCServer) BackfillQuote(ctx context.Context, req *proto.BackfillQuoteRequest) (*proto.JobReply, error) {
+	_, rep, err := s.backfill.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.JobReply), nil
+}
+
+func serveQuoteReply(ctx context.Context, handler grpctransport.Handler, req interface{}) (*proto.QuoteReply, error) {
+	_, rep, err := handler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.QuoteReply), nil
+}
+
+func decodeGRPCCreateQuoteRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.CreateQuoteRequest)
+	return CreateQuoteRequest{
+		Quote: quote.Quote{
+			Symbol:         req.Symbol,
+			Name:           req.Name,
+			Provider:       req.Provider,
+			ProviderSymbol: req.ProviderSymbol,
+			Exchange:       req.Exchange,
+			Currency:       req.Currency,
+			AssetClass:     quote.AssetClass(req.AssetClass),
+			Timezone:       req.Timezone,
+			TradingHours:   req.TradingHours,
+		},
+	}, nil
+}
+
+func decodeGRPCRenameQuoteRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.RenameQuoteRequest)
+	return RenameQuoteRequest{
+		Symbol:    req.Symbol,
+		NewSymbol: req.NewSymbol,
+		NewName:   req.NewName,
+	}, nil
+}
+
+func decodeGRPCUpdateQuoteMetadataRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.UpdateQuoteMetadataRequest)
+	return UpdateQuoteRequest{
+		Symbol: req.Symbol,
+		Metadata: quote.Quote{
+			Exchange:     req.Exchange,
+			Currency:     req.Currency,
+			AssetClass:   quote.AssetClass(req.AssetClass),
+			Timezone:     req.Timezone,
+			TradingHours: req.TradingHours,
+		},
+	}, nil
+}
+
+func decodeGRPCQuoteSymbolRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.QuoteSymbolRequest)
+	return QuoteSymbolRequest{Symbol: req.Symbol}, nil
+}
+
+func encodeGRPCQuoteResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(QuoteResponse)
+	reply := &proto.QuoteReply{Err: err2str(resp.Err)}
+	if resp.Quote != nil {
+		reply.Quote = encodeQuote(*resp.Quote)
+	}
+	return reply, nil
+}
+
+func decodeGRPCListJobsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.ListJobsRequest)
+	filter := app.JobFilter{
+		Type:   job.Type(req.Type),
+		Symbol: req.Symbol,
+		Limit:  int(req.Limit),
+	}
+	for _, status := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, job.Status(status))
+	}
+
+	return ListJobsRequest{Filter: filter}, nil
+}
+
+func decodeGRPCBackfillQuoteRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.BackfillQuoteRequest)
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var to time.Time
+	if req.To != "" {
+		to, err = time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return BackfillRequest{Symbol: req.Symbol, From: from, To: to}, nil
+}
+
+func encodeGRPCListJobsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(ListJobsResponse)
+	reply := &proto.ListJobsReply{Err: err2str(resp.Err)}
+	for i := range resp.Jobs {
+		reply.Jobs = append(reply.Jobs, encodeJob(resp.Jobs[i]))
+	}
+	return reply, nil
+}
+
+func encodeGRPCJobResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(JobResponse)
+	reply := &proto.JobReply{Err: err2str(resp.Err)}
+	if resp.Job != nil {
+		reply.Job = encodeJob(*resp.Job)
+	}
+	return reply, nil
+}
+
+func encodeJob(j job.Job) *proto.Job {
+	return &proto.Job{
+		Id:          j.ID,
+		Type:        string(j.Type),
+		QuoteId:     j.QuoteID,
+		Symbol:      j.Symbol,
+		From:        unix(j.From),
+		To:          unix(j.To),
+		Status:      string(j.Status),
+		Attempts:    int32(j.Attempts),
+		MaxAttempts: int32(j.MaxAttempts),
+		Error:       j.Error,
+		RunAt:       unix(j.RunAt),
+		CreatedAt:   unix(j.CreatedAt),
+		StartedAt:   unix(j.StartedAt),
+		FinishedAt:  unix(j.FinishedAt),
+	}
+}
+
+// unix keeps the zero time zero instead of a large negative number
+func unix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
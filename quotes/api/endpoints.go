@@ -18,8 +18,12 @@ import (
 )
 
 type Quotes struct {
-	GetQuotesEndpoint       endpoint.Endpoint
-	GetCandlesticksEndpoint endpoint.Endpoint
+	GetQuotesEndpoint              endpoint.Endpoint
+	GetCandlesticksEndpoint        endpoint.Endpoint
+	GetCandlesticksBatchEndpoint   endpoint.Endpoint
+	StreamCandlesticksEndpoint     endpoint.Endpoint
+	GetCandlesticksByCountEndpoint endpoint.Endpoint
+	GetCandlestickIssuesEndpoint   endpoint.Endpoint
 }
 
 func NewQuotes(svc app.QuotesApp, logger log.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) Quotes {
@@ -47,15 +51,60 @@ func NewQuotes(svc app.QuotesApp, logger log.Logger, duration metrics.Histogram,
 		getCandlesticksEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticks"))(getCandlesticksEndpoint)
 		getCandlesticksEndpoint = InstrumentingMiddleware(duration.With("method", "GetCandlesticks"))(getCandlesticksEndpoint)
 	}
+	var getCandlesticksBatchEndpoint endpoint.Endpoint
+	{
+		getCandlesticksBatchEndpoint = MakeGetCandlesticksBatchEndpoint(svc)
+		getCandlesticksBatchEndpoint = opentracing.TraceServer(otTracer, "GetCandlesticksBatch")(getCandlesticksBatchEndpoint)
+		if zipkinTracer != nil {
+			getCandlesticksBatchEndpoint = zipkin.TraceEndpoint(zipkinTracer, "GetCandlesticksBatch")(getCandlesticksBatchEndpoint)
+		}
+		getCandlesticksBatchEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticksBatch"))(getCandlesticksBatchEndpoint)
+		getCandlesticksBatchEndpoint = InstrumentingMiddleware(duration.With("method", "GetCandlesticksBatch"))(getCandlesticksBatchEndpoint)
+	}
+	var streamCandlesticksEndpoint endpoint.Endpoint
+	{
+		streamCandlesticksEndpoint = MakeStreamCandlesticksEndpoint(svc)
+		streamCandlesticksEndpoint = opentracing.TraceServer(otTracer, "StreamCandlesticks")(streamCandlesticksEndpoint)
+		if zipkinTracer != nil {
+			streamCandlesticksEndpoint = zipkin.TraceEndpoint(zipkinTracer, "StreamCandlesticks")(streamCandlesticksEndpoint)
+		}
+		streamCandlesticksEndpoint = LoggingMiddleware(log.With(logger, "method", "StreamCandlesticks"))(streamCandlesticksEndpoint)
+		streamCandlesticksEndpoint = InstrumentingMiddleware(duration.With("method", "StreamCandlesticks"))(streamCandlesticksEndpoint)
+	}
+	var getCandlesticksByCountEndpoint endpoint.Endpoint
+	{
+		getCandlesticksByCountEndpoint = MakeGetCandlesticksByCountEndpoint(svc)
+		getCandlesticksByCountEndpoint = opentracing.TraceServer(otTracer, "GetCandlesticksByCount")(getCandlesticksByCountEndpoint)
+		if zipkinTracer != nil {
+			getCandlesticksByCountEndpoint = zipkin.TraceEndpoint(zipkinTracer, "GetCandlesticksByCount")(getCandlesticksByCountEndpoint)
+		}
+		getCandlesticksByCountEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlesticksByCount"))(getCandlesticksByCountEndpoint)
+		getCandlesticksByCountEndpoint = InstrumentingMiddleware(duration.With("method", "GetCandlesticksByCount"))(getCandlesticksByCountEndpoint)
+	}
+	var getCandlestickIssuesEndpoint endpoint.Endpoint
+	{
+		getCandlestickIssuesEndpoint = MakeGetCandlestickIssuesEndpoint(svc)
+		getCandlestickIssuesEndpoint = opentracing.TraceServer(otTracer, "GetCandlestickIssues")(getCandlestickIssuesEndpoint)
+		if zipkinTracer != nil {
+			getCandlestickIssuesEndpoint = zipkin.TraceEndpoint(zipkinTracer, "GetCandlestickIssues")(getCandlestickIssuesEndpoint)
+		}
+		getCandlestickIssuesEndpoint = LoggingMiddleware(log.With(logger, "method", "GetCandlestickIssues"))(getCandlestickIssuesEndpoint)
+		getCandlestickIssuesEndpoint = InstrumentingMiddleware(duration.With("method", "GetCandlestickIssues"))(getCandlestickIssuesEndpoint)
+	}
 	return Quotes{
-		GetQuotesEndpoint:       getQuotesEndpoint,
-		GetCandlesticksEndpoint: getCandlesticksEndpoint,
+		GetQuotesEndpoint:              getQuotesEndpoint,
+		GetCandlesticksEndpoint:        getCandlesticksEndpoint,
+		GetCandlesticksBatchEndpoint:   getCandlesticksBatchEndpoint,
+		StreamCandlesticksEndpoint:     streamCandlesticksEndpoint,
+		GetCandlesticksByCountEndpoint: getCandlesticksByCountEndpoint,
+		GetCandlestickIssuesEndpoint:   getCandlestickIssuesEndpoint,
 	}
 }
 
 func MakeGetQuotesEndpoint(s app.QuotesApp) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		quotes, err := s.GetQuotes()
+		req := request.(GetQuotesRequest)
+		quotes, err := s.GetQuotes(req.Filter)
 		return GetQuotesResponse{Quotes: quotes, Err: err}, nil
 	}
 }
@@ -63,17 +112,54 @@ func MakeGetQuotesEndpoint(s app.QuotesApp) endpoint.Endpoint {
 func MakeGetCandlesticksEndpoint(s app.QuotesApp) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetCandlesticksRequest)
-		candlesticks, err := s.GetCandlesticks(req.Symbol, req.Interval, req.From, req.To)
+		candlesticks, err := s.GetCandlesticks(req.Symbol, req.Interval, req.From, req.To, req.Adjusted)
+		return GetCandlesticksResponse{Candlesticks: candlesticks, Err: err}, nil
+	}
+}
+
+func MakeGetCandlesticksBatchEndpoint(s app.QuotesApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCandlesticksBatchRequest)
+		candlesticks, err := s.GetCandlesticksBatch(req.Symbols, req.Interval, req.From, req.To, req.Adjusted)
+		return GetCandlesticksBatchResponse{Candlesticks: candlesticks, Err: err}, nil
+	}
+}
+
+func MakeStreamCandlesticksEndpoint(s app.QuotesApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(StreamCandlesticksRequest)
+		err := s.StreamCandlesticks(req.Symbol, req.Interval, req.From, req.To, req.Adjusted, req.ChunkSize, req.Send)
+		return StreamCandlesticksResponse{Err: err}, nil
+	}
+}
+
+func MakeGetCandlesticksByCountEndpoint(s app.QuotesApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCandlesticksByCountRequest)
+		candlesticks, err := s.GetCandlesticksByCount(req.Symbol, req.Interval, req.Start, req.Direction, req.Count, req.Adjusted)
 		return GetCandlesticksResponse{Candlesticks: candlesticks, Err: err}, nil
 	}
 }
 
+func MakeGetCandlestickIssuesEndpoint(s app.QuotesApp) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCandlestickIssuesRequest)
+		issues, err := s.GetCandlestickIssues(req.Symbol, req.Interval, req.From, req.To)
+		return GetCandlestickIssuesResponse{Issues: issues, Err: err}, nil
+	}
+}
+
 var (
 	_ endpoint.Failer = GetQuotesResponse{}
 	_ endpoint.Failer = GetCandlesticksResponse{}
+	_ endpoint.Failer = GetCandlesticksBatchResponse{}
+	_ endpoint.Failer = StreamCandlesticksResponse{}
+	_ endpoint.Failer = GetCandlestickIssuesResponse{}
 )
 
-type GetQuotesRequest struct{}
+type GetQuotesRequest struct {
+	Filter quote.Filter
+}
 
 type GetQuotesResponse struct {
 	Quotes []quote.Quote
@@ -86,6 +172,7 @@ type GetCandlesticksRequest struct {
 	Symbol   string
 	Interval candlestick.Interval
 	From, To time.Time
+	Adjusted bool
 }
 
 type GetCandlesticksResponse struct {
@@ -94,3 +181,52 @@ type GetCandlesticksResponse struct {
 }
 
 func (r GetCandlesticksResponse) Failed() error { return r.Err }
+
+type GetCandlesticksBatchRequest struct {
+	Symbols  []string
+	Interval candlestick.Interval
+	From, To time.Time
+	Adjusted bool
+}
+
+type GetCandlesticksBatchResponse struct {
+	Candlesticks map[string][]candlestick.Candlestick
+	Err          error
+}
+
+func (r GetCandlesticksBatchResponse) Failed() error { return r.Err }
+
+// StreamCandlesticksRequest carries the send of the stream, go-kit transport has no streaming of its own
+type StreamCandlesticksRequest struct {
+	GetCandlesticksRequest
+	ChunkSize int
+	Send      func([]candlestick.Candlestick) error
+}
+
+type StreamCandlesticksResponse struct {
+	Err error
+}
+
+func (r StreamCandlesticksResponse) Failed() error { return r.Err }
+
+type GetCandlesticksByCountRequest struct {
+	Symbol    string
+	Interval  candlestick.Interval
+	Start     time.Time
+	Direction candlestick.Direction
+	Count     int
+	Adjusted  bool
+}
+
+type GetCandlestickIssuesRequest struct {
+	Symbol   string
+	Interval candlestick.Interval
+	From, To time.Time
+}
+
+type GetCandlestickIssuesResponse struct {
+	Issues []candlestick.Issue
+	Err    error
+}
+
+func (r GetCandlestickIssuesResponse) Failed() error { return r.Err }
@@ -15,6 +15,7 @@ import (
 
 	"github.com/websmee/example_of_my_code/quotes/api/proto"
 	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
 )
 
 type grpcServer struct {
@@ -56,19 +57,22 @@ func (s *grpcServer) GetQuotes(ctx context.Context, req *proto.GetQuotesRequest)
 	return rep.(*proto.GetQuotesReply), nil
 }
 
-func decodeGRPCGetQuotesRequest(_ context.Context, _ interface{}) (interface{}, error) {
-	return GetQuotesRequest{}, nil
+func decodeGRPCGetQuotesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetQuotesRequest)
+	return GetQuotesRequest{
+		Filter: quote.Filter{
+			Exchange:   req.Exchange,
+			Currency:   req.Currency,
+			AssetClass: quote.AssetClass(req.AssetClass),
+		},
+	}, nil
 }
 
 func encodeGRPCGetQuotesResponse(_ context.Context, response interface{}) (interface{}, error) {
 	resp := response.(GetQuotesResponse)
 	quotes := make(map[int64]*proto.Quote, len(resp.Quotes))
 	for i := range resp.Quotes {
-		quotes[int64(i)] = &proto.Quote{
-			Id:     resp.Quotes[i].ID,
-			Symbol: resp.Quotes[i].Symbol,
-			Name:   resp.Quotes[i].Name,
-		}
+		quotes[int64(i)] = encodeQuote(resp.Quotes[i])
 	}
 	return &proto.GetQuotesReply{Quotes: quotes, Err: err2str(resp.Err)}, nil
 }
@@ -83,10 +87,16 @@ func (s *grpcServer) GetCandlesticks(ctx context.Context, req *proto.GetCandlest
 
 func decodeGRPCGetCandlesticksRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	var from, to time.Time
+	var interval candlestick.Interval
 	var err error
 
 	req := grpcReq.(*proto.GetCandlesticksRequest)
 
+	interval, err = candlestick.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
 	from, err = time.Parse(time.RFC3339, req.From)
 	if err != nil {
 		return nil, err
@@ -99,9 +109,10 @@ func decodeGRPCGetCandlesticksRequest(_ context.Context, grpcReq interface{}) (i
 
 	return GetCandlesticksRequest{
 		Symbol:   req.Symbol,
-		Interval: candlestick.Interval(req.Interval),
+		Interval: interval,
 		From:     from,
 		To:       to,
+		Adjusted: req.Adjusted,
 	}, err
 }
 
@@ -124,6 +135,22 @@ func encodeGRPCGetCandlesticksResponse(_ context.Context, response interface{})
 	return &proto.GetCandlesticksReply{Candlesticks: candlesticks, Err: err2str(resp.Err)}, nil
 }
 
+func encodeQuote(q quote.Quote) *proto.Quote {
+	return &proto.Quote{
+		Id:             q.ID,
+		Symbol:         q.Symbol,
+		Name:           q.Name,
+		Status:         string(q.Status),
+		Provider:       q.Provider,
+		ProviderSymbol: q.ProviderSymbol,
+		Exchange:       q.Exchange,
+		Currency:       q.Currency,
+		AssetClass:     string(q.AssetClass),
+		Timezone:       q.Timezone,
+		TradingHours:   q.TradingHours,
+	}
+}
+
 func err2str(err error) string {
 	if err == nil {
 		return ""
@@ -0,0 +1,337 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+	"github.com/websmee/example_of_my_code/quotes/app"
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+)
+
+const (
+	defaultStreamChunkSize = 1000
+	maxStreamChunkSize     = 10000
+)
+
+type grpcServerV2 struct {
+	proto.UnimplementedQuotesV2Server
+	getQuotes              grpctransport.Handler
+	getCandlesticks        grpctransport.Handler
+	getCandlesticksBatch   grpctransport.Handler
+	getCandlesticksByCount grpctransport.Handler
+	getCandlestickIssues   grpctransport.Handler
+	streamCandlesticks     endpoint.Endpoint
+	bus                    app.CandlestickBus
+	otTracer               stdopentracing.Tracer
+	logger                 log.Logger
+}
+
+// NewGRPCServerV2 serves the same endpoints as NewGRPCServer, but keeps prices as decimal strings.
+// The candlestick subscriptions are served from the bus.
+func NewGRPCServerV2(endpoints Quotes, bus app.CandlestickBus, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) proto.QuotesV2Server {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer))
+	}
+
+	return &grpcServerV2{
+		getQuotes: grpctransport.NewServer(
+			endpoints.GetQuotesEndpoint,
+			decodeGRPCGetQuotesRequest,
+			encodeGRPCGetQuotesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetQuotes", logger)))...,
+		),
+		getCandlesticks: grpctransport.NewServer(
+			endpoints.GetCandlesticksEndpoint,
+			decodeGRPCGetCandlesticksRequest,
+			encodeGRPCGetCandlesticksV2Response,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetCandlesticks", logger)))...,
+		),
+		getCandlesticksBatch: grpctransport.NewServer(
+			endpoints.GetCandlesticksBatchEndpoint,
+			decodeGRPCGetCandlesticksBatchRequest,
+			encodeGRPCGetCandlesticksBatchResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetCandlesticksBatch", logger)))...,
+		),
+		getCandlesticksByCount: grpctransport.NewServer(
+			endpoints.GetCandlesticksByCountEndpoint,
+			decodeGRPCGetCandlesticksByCountRequest,
+			encodeGRPCGetCandlesticksV2Response,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetCandlesticksByCount", logger)))...,
+		),
+		getCandlestickIssues: grpctransport.NewServer(
+			endpoints.GetCandlestickIssuesEndpoint,
+			decodeGRPCGetCandlestickIssuesRequest,
+			encodeGRPCGetCandlestickIssuesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "GetCandlestickIssues", logger)))...,
+		),
+		streamCandlesticks: endpoints.StreamCandlesticksEndpoint,
+		bus:                bus,
+		otTracer:           otTracer,
+		logger:             logger,
+	}
+}
+
+func (s *grpcServerV2) GetQuotes(ctx context.Context, req *proto.GetQuotesRequest) (*proto.GetQuotesReply, error) {
+	_, rep, err := s.getQuotes.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetQuotesReply), nil
+}
+
+func (s *grpcServerV2) GetCandlesticks(ctx context.Context, req *proto.GetCandlesticksRequest) (*proto.GetCandlesticksV2Reply, error) {
+	_, rep, err := s.getCandlesticks.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetCandlesticksV2Reply), nil
+}
+
+func (s *grpcServerV2) GetCandlesticksBatch(ctx context.Context, req *proto.GetCandlesticksBatchRequest) (*proto.GetCandlesticksBatchReply, error) {
+	_, rep, err := s.getCandlesticksBatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetCandlesticksBatchReply), nil
+}
+
+func (s *grpcServerV2) GetCandlesticksByCount(ctx context.Context, req *proto.GetCandlesticksByCountRequest) (*proto.GetCandlesticksV2Reply, error) {
+	_, rep, err := s.getCandlesticksByCount.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetCandlesticksV2Reply), nil
+}
+
+func (s *grpcServerV2) GetCandlestickIssues(ctx context.Context, req *proto.GetCandlestickIssuesRequest) (*proto.GetCandlestickIssuesReply, error) {
+	_, rep, err := s.getCandlestickIssues.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*proto.GetCandlestickIssuesReply), nil
+}
+
+// StreamCandlesticks isn't supported by go-kit transport, so it passes the stream to the endpoint in the request.
+// Every page is sent as soon as it is read, Send blocks while the client is behind, which keeps the server from racing ahead.
+func (s *grpcServerV2) StreamCandlesticks(req *proto.StreamCandlesticksRequest, stream proto.QuotesV2_StreamCandlesticksServer) error {
+	ctx := stream.Context()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = opentracing.GRPCToContext(s.otTracer, "StreamCandlesticks", s.logger)(ctx, md)
+	}
+
+	request, err := decodeGRPCGetCandlesticksRequest(ctx, &proto.GetCandlesticksRequest{
+		Symbol:   req.Symbol,
+		Interval: req.Interval,
+		From:     req.From,
+		To:       req.To,
+		Adjusted: req.Adjusted,
+	})
+	if err != nil {
+		return err
+	}
+
+	chunkSize := int(req.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	if chunkSize > maxStreamChunkSize {
+		chunkSize = maxStreamChunkSize
+	}
+
+	response, err := s.streamCandlesticks(ctx, StreamCandlesticksRequest{
+		GetCandlesticksRequest: request.(GetCandlesticksRequest),
+		ChunkSize:              chunkSize,
+		Send: func(cs []candlestick.Candlestick) error {
+			chunk := make([]*proto.CandlestickV2, len(cs))
+			for i := range cs {
+				chunk[i] = encodeCandlestickV2(cs[i])
+			}
+			return stream.Send(&proto.CandlesticksChunk{Candlesticks: chunk})
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.(StreamCandlesticksResponse).Err
+}
+
+// SubscribeCandlesticks isn't supported by go-kit transport either, it sends the events until the client leaves.
+func (s *grpcServerV2) SubscribeCandlesticks(req *proto.SubscribeCandlesticksRequest, stream proto.QuotesV2_SubscribeCandlesticksServer) error {
+	interval, err := candlestick.ParseInterval(req.Interval)
+	if err != nil {
+		return err
+	}
+
+	events, cancel := s.bus.Subscribe(req.Symbols, interval)
+	defer cancel()
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return errors.New("subscriber is too far behind")
+			}
+			if err := stream.Send(&proto.CandlestickEvent{
+				Symbol:      e.Symbol,
+				Candlestick: encodeCandlestickV2(e.Candlestick),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func encodeGRPCGetCandlesticksV2Response(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetCandlesticksResponse)
+	candlesticks := make([]*proto.CandlestickV2, len(resp.Candlesticks))
+	for i := range resp.Candlesticks {
+		candlesticks[i] = encodeCandlestickV2(resp.Candlesticks[i])
+	}
+	return &proto.GetCandlesticksV2Reply{Candlesticks: candlesticks, Err: err2str(resp.Err)}, nil
+}
+
+func decodeGRPCGetCandlesticksBatchRequest(ctx context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetCandlesticksBatchRequest)
+
+	request, err := decodeGRPCGetCandlesticksRequest(ctx, &proto.GetCandlesticksRequest{
+		Interval: req.Interval,
+		From:     req.From,
+		To:       req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	single := request.(GetCandlesticksRequest)
+	return GetCandlesticksBatchRequest{
+		Symbols:  req.Symbols,
+		Interval: single.Interval,
+		From:     single.From,
+		To:       single.To,
+		Adjusted: req.Adjusted,
+	}, nil
+}
+
+func decodeGRPCGetCandlesticksByCountRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetCandlesticksByCountRequest)
+
+	interval, err := candlestick.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(time.RFC3339, req.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, err := candlestick.ParseDirection(req.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCandlesticksByCountRequest{
+		Symbol:    req.Symbol,
+		Interval:  interval,
+		Start:     start,
+		Direction: direction,
+		Count:     int(req.Count),
+		Adjusted:  req.Adjusted,
+	}, nil
+}
+
+func encodeGRPCGetCandlesticksBatchResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetCandlesticksBatchResponse)
+	symbols := make([]string, 0, len(resp.Candlesticks))
+	for symbol := range resp.Candlesticks {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	items := make([]*proto.SymbolCandlesticks, len(symbols))
+	for i, symbol := range symbols {
+		candlesticks := make([]*proto.CandlestickV2, len(resp.Candlesticks[symbol]))
+		for j := range resp.Candlesticks[symbol] {
+			candlesticks[j] = encodeCandlestickV2(resp.Candlesticks[symbol][j])
+		}
+		items[i] = &proto.SymbolCandlesticks{Symbol: symbol, Candlesticks: candlesticks}
+	}
+	return &proto.GetCandlesticksBatchReply{Items: items, Err: err2str(resp.Err)}, nil
+}
+
+func decodeGRPCGetCandlestickIssuesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*proto.GetCandlestickIssuesRequest)
+
+	interval, err := candlestick.ParseInterval(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCandlestickIssuesRequest{
+		Symbol:   req.Symbol,
+		Interval: interval,
+		From:     from,
+		To:       to,
+	}, nil
+}
+
+func encodeGRPCGetCandlestickIssuesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(GetCandlestickIssuesResponse)
+	issues := make([]*proto.CandlestickIssue, len(resp.Issues))
+	for i, issue := range resp.Issues {
+		issues[i] = &proto.CandlestickIssue{
+			QuoteId:    issue.QuoteID,
+			Interval:   string(issue.Interval),
+			Timestamp:  issue.Timestamp.Unix(),
+			Type:       string(issue.Type),
+			Resolution: string(issue.Resolution),
+			Details:    issue.Details,
+			CreatedAt:  issue.CreatedAt.Unix(),
+		}
+	}
+	return &proto.GetCandlestickIssuesReply{Issues: issues, Err: err2str(resp.Err)}, nil
+}
+
+func encodeCandlestickV2(c candlestick.Candlestick) *proto.CandlestickV2 {
+	return &proto.CandlestickV2{
+		Open:      c.Open.String(),
+		Low:       c.Low.String(),
+		High:      c.High.String(),
+		Close:     c.Close.String(),
+		AdjClose:  c.AdjClose.String(),
+		Volume:    int64(c.Volume),
+		Timestamp: c.Timestamp.Unix(),
+		Interval:  string(c.Interval),
+		QuoteId:   c.QuoteID,
+	}
+}
@@ -136,3 +136,754 @@ var Quotes_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/quotes.proto",
 }
+
+// QuotesV2Client is the client API for QuotesV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotesV2Client interface {
+	GetQuotes(ctx context.Context, in *GetQuotesRequest, opts ...grpc.CallOption) (*GetQuotesReply, error)
+	GetCandlesticks(ctx context.Context, in *GetCandlesticksRequest, opts ...grpc.CallOption) (*GetCandlesticksV2Reply, error)
+	// StreamCandlesticks sends large ranges as ordered chunks, every chunk is sent once it is read from the storage.
+	// The error is returned as the stream status.
+	StreamCandlesticks(ctx context.Context, in *StreamCandlesticksRequest, opts ...grpc.CallOption) (QuotesV2_StreamCandlesticksClient, error)
+	GetCandlesticksBatch(ctx context.Context, in *GetCandlesticksBatchRequest, opts ...grpc.CallOption) (*GetCandlesticksBatchReply, error)
+	// GetCandlesticksByCount returns exactly count candlesticks next to the start unless the history is shorter
+	GetCandlesticksByCount(ctx context.Context, in *GetCandlesticksByCountRequest, opts ...grpc.CallOption) (*GetCandlesticksV2Reply, error)
+	// GetCandlestickIssues returns what the validation of loaded candlesticks found
+	GetCandlestickIssues(ctx context.Context, in *GetCandlestickIssuesRequest, opts ...grpc.CallOption) (*GetCandlestickIssuesReply, error)
+	// SubscribeCandlesticks sends the candlesticks stored by the latest loads once their period is over.
+	// The stream is closed if the client falls too far behind, it should resubscribe and catch up by the history.
+	SubscribeCandlesticks(ctx context.Context, in *SubscribeCandlesticksRequest, opts ...grpc.CallOption) (QuotesV2_SubscribeCandlesticksClient, error)
+}
+
+type quotesV2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotesV2Client(cc grpc.ClientConnInterface) QuotesV2Client {
+	return &quotesV2Client{cc}
+}
+
+func (c *quotesV2Client) GetQuotes(ctx context.Context, in *GetQuotesRequest, opts ...grpc.CallOption) (*GetQuotesReply, error) {
+	out := new(GetQuotesReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesV2/GetQuotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesV2Client) GetCandlesticks(ctx context.Context, in *GetCandlesticksRequest, opts ...grpc.CallOption) (*GetCandlesticksV2Reply, error) {
+	out := new(GetCandlesticksV2Reply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesV2/GetCandlesticks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesV2Client) StreamCandlesticks(ctx context.Context, in *StreamCandlesticksRequest, opts ...grpc.CallOption) (QuotesV2_StreamCandlesticksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QuotesV2_ServiceDesc.Streams[0], "/proto.QuotesV2/StreamCandlesticks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &quotesV2StreamCandlesticksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QuotesV2_StreamCandlesticksClient interface {
+	Recv() (*CandlesticksChunk, error)
+	grpc.ClientStream
+}
+
+type quotesV2StreamCandlesticksClient struct {
+	grpc.ClientStream
+}
+
+func (x *quotesV2StreamCandlesticksClient) Recv() (*CandlesticksChunk, error) {
+	m := new(CandlesticksChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *quotesV2Client) GetCandlesticksBatch(ctx context.Context, in *GetCandlesticksBatchRequest, opts ...grpc.CallOption) (*GetCandlesticksBatchReply, error) {
+	out := new(GetCandlesticksBatchReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesV2/GetCandlesticksBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesV2Client) GetCandlesticksByCount(ctx context.Context, in *GetCandlesticksByCountRequest, opts ...grpc.CallOption) (*GetCandlesticksV2Reply, error) {
+	out := new(GetCandlesticksV2Reply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesV2/GetCandlesticksByCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesV2Client) GetCandlestickIssues(ctx context.Context, in *GetCandlestickIssuesRequest, opts ...grpc.CallOption) (*GetCandlestickIssuesReply, error) {
+	out := new(GetCandlestickIssuesReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesV2/GetCandlestickIssues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesV2Client) SubscribeCandlesticks(ctx context.Context, in *SubscribeCandlesticksRequest, opts ...grpc.CallOption) (QuotesV2_SubscribeCandlesticksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QuotesV2_ServiceDesc.Streams[1], "/proto.QuotesV2/SubscribeCandlesticks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &quotesV2SubscribeCandlesticksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QuotesV2_SubscribeCandlesticksClient interface {
+	Recv() (*CandlestickEvent, error)
+	grpc.ClientStream
+}
+
+type quotesV2SubscribeCandlesticksClient struct {
+	grpc.ClientStream
+}
+
+func (x *quotesV2SubscribeCandlesticksClient) Recv() (*CandlestickEvent, error) {
+	m := new(CandlestickEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QuotesV2Server is the server API for QuotesV2 service.
+// All implementations must embed UnimplementedQuotesV2Server
+// for forward compatibility
+type QuotesV2Server interface {
+	GetQuotes(context.Context, *GetQuotesRequest) (*GetQuotesReply, error)
+	GetCandlesticks(context.Context, *GetCandlesticksRequest) (*GetCandlesticksV2Reply, error)
+	// StreamCandlesticks sends large ranges as ordered chunks, every chunk is sent once it is read from the storage.
+	// The error is returned as the stream status.
+	StreamCandlesticks(*StreamCandlesticksRequest, QuotesV2_StreamCandlesticksServer) error
+	GetCandlesticksBatch(context.Context, *GetCandlesticksBatchRequest) (*GetCandlesticksBatchReply, error)
+	// GetCandlesticksByCount returns exactly count candlesticks next to the start unless the history is shorter
+	GetCandlesticksByCount(context.Context, *GetCandlesticksByCountRequest) (*GetCandlesticksV2Reply, error)
+	// GetCandlestickIssues returns what the validation of loaded candlesticks found
+	GetCandlestickIssues(context.Context, *GetCandlestickIssuesRequest) (*GetCandlestickIssuesReply, error)
+	// SubscribeCandlesticks sends the candlesticks stored by the latest loads once their period is over.
+	// The stream is closed if the client falls too far behind, it should resubscribe and catch up by the history.
+	SubscribeCandlesticks(*SubscribeCandlesticksRequest, QuotesV2_SubscribeCandlesticksServer) error
+	mustEmbedUnimplementedQuotesV2Server()
+}
+
+// UnimplementedQuotesV2Server must be embedded to have forward compatible implementations.
+type UnimplementedQuotesV2Server struct {
+}
+
+func (UnimplementedQuotesV2Server) GetQuotes(context.Context, *GetQuotesRequest) (*GetQuotesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotes not implemented")
+}
+func (UnimplementedQuotesV2Server) GetCandlesticks(context.Context, *GetCandlesticksRequest) (*GetCandlesticksV2Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandlesticks not implemented")
+}
+func (UnimplementedQuotesV2Server) StreamCandlesticks(*StreamCandlesticksRequest, QuotesV2_StreamCandlesticksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCandlesticks not implemented")
+}
+func (UnimplementedQuotesV2Server) GetCandlesticksBatch(context.Context, *GetCandlesticksBatchRequest) (*GetCandlesticksBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandlesticksBatch not implemented")
+}
+func (UnimplementedQuotesV2Server) GetCandlesticksByCount(context.Context, *GetCandlesticksByCountRequest) (*GetCandlesticksV2Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandlesticksByCount not implemented")
+}
+func (UnimplementedQuotesV2Server) GetCandlestickIssues(context.Context, *GetCandlestickIssuesRequest) (*GetCandlestickIssuesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandlestickIssues not implemented")
+}
+func (UnimplementedQuotesV2Server) SubscribeCandlesticks(*SubscribeCandlesticksRequest, QuotesV2_SubscribeCandlesticksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCandlesticks not implemented")
+}
+func (UnimplementedQuotesV2Server) mustEmbedUnimplementedQuotesV2Server() {}
+
+// UnsafeQuotesV2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotesV2Server will
+// result in compilation errors.
+type UnsafeQuotesV2Server interface {
+	mustEmbedUnimplementedQuotesV2Server()
+}
+
+func RegisterQuotesV2Server(s grpc.ServiceRegistrar, srv QuotesV2Server) {
+	s.RegisterService(&QuotesV2_ServiceDesc, srv)
+}
+
+func _QuotesV2_GetQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesV2Server).GetQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesV2/GetQuotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesV2Server).GetQuotes(ctx, req.(*GetQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesV2_GetCandlesticks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesticksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesV2Server).GetCandlesticks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesV2/GetCandlesticks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesV2Server).GetCandlesticks(ctx, req.(*GetCandlesticksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesV2_StreamCandlesticks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCandlesticksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuotesV2Server).StreamCandlesticks(m, &quotesV2StreamCandlesticksServer{stream})
+}
+
+type QuotesV2_StreamCandlesticksServer interface {
+	Send(*CandlesticksChunk) error
+	grpc.ServerStream
+}
+
+type quotesV2StreamCandlesticksServer struct {
+	grpc.ServerStream
+}
+
+func (x *quotesV2StreamCandlesticksServer) Send(m *CandlesticksChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QuotesV2_GetCandlesticksBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesticksBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesV2Server).GetCandlesticksBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesV2/GetCandlesticksBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesV2Server).GetCandlesticksBatch(ctx, req.(*GetCandlesticksBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesV2_GetCandlesticksByCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesticksByCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesV2Server).GetCandlesticksByCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesV2/GetCandlesticksByCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesV2Server).GetCandlesticksByCount(ctx, req.(*GetCandlesticksByCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesV2_GetCandlestickIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlestickIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesV2Server).GetCandlestickIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesV2/GetCandlestickIssues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesV2Server).GetCandlestickIssues(ctx, req.(*GetCandlestickIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesV2_SubscribeCandlesticks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeCandlesticksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuotesV2Server).SubscribeCandlesticks(m, &quotesV2SubscribeCandlesticksServer{stream})
+}
+
+type QuotesV2_SubscribeCandlesticksServer interface {
+	Send(*CandlestickEvent) error
+	grpc.ServerStream
+}
+
+type quotesV2SubscribeCandlesticksServer struct {
+	grpc.ServerStream
+}
+
+func (x *quotesV2SubscribeCandlesticksServer) Send(m *CandlestickEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// QuotesV2_ServiceDesc is the grpc.ServiceDesc for QuotesV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuotesV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.QuotesV2",
+	HandlerType: (*QuotesV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetQuotes",
+			Handler:    _QuotesV2_GetQuotes_Handler,
+		},
+		{
+			MethodName: "GetCandlesticks",
+			Handler:    _QuotesV2_GetCandlesticks_Handler,
+		},
+		{
+			MethodName: "GetCandlesticksBatch",
+			Handler:    _QuotesV2_GetCandlesticksBatch_Handler,
+		},
+		{
+			MethodName: "GetCandlesticksByCount",
+			Handler:    _QuotesV2_GetCandlesticksByCount_Handler,
+		},
+		{
+			MethodName: "GetCandlestickIssues",
+			Handler:    _QuotesV2_GetCandlestickIssues_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCandlesticks",
+			Handler:       _QuotesV2_StreamCandlesticks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCandlesticks",
+			Handler:       _QuotesV2_SubscribeCandlesticks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/quotes.proto",
+}
+
+// QuotesAdminClient is the client API for QuotesAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotesAdminClient interface {
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	RenameQuote(ctx context.Context, in *RenameQuoteRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	// UpdateQuoteMetadata changes the fields that are set, the trading calendar of the quote follows them
+	UpdateQuoteMetadata(ctx context.Context, in *UpdateQuoteMetadataRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	SuspendQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	ResumeQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	DeleteQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	// LoadQuote schedules a load job of a new quote, it becomes ready when the job is done
+	LoadQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error)
+	// ListJobs returns the latest ingestion jobs first
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error)
+	// BackfillQuote schedules a job reloading a range of the quote history
+	BackfillQuote(ctx context.Context, in *BackfillQuoteRequest, opts ...grpc.CallOption) (*JobReply, error)
+}
+
+type quotesAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotesAdminClient(cc grpc.ClientConnInterface) QuotesAdminClient {
+	return &quotesAdminClient{cc}
+}
+
+func (c *quotesAdminClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/CreateQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) RenameQuote(ctx context.Context, in *RenameQuoteRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/RenameQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) UpdateQuoteMetadata(ctx context.Context, in *UpdateQuoteMetadataRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/UpdateQuoteMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) SuspendQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/SuspendQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) ResumeQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/ResumeQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) DeleteQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/DeleteQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) LoadQuote(ctx context.Context, in *QuoteSymbolRequest, opts ...grpc.CallOption) (*QuoteReply, error) {
+	out := new(QuoteReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/LoadQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error) {
+	out := new(ListJobsReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotesAdminClient) BackfillQuote(ctx context.Context, in *BackfillQuoteRequest, opts ...grpc.CallOption) (*JobReply, error) {
+	out := new(JobReply)
+	err := c.cc.Invoke(ctx, "/proto.QuotesAdmin/BackfillQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotesAdminServer is the server API for QuotesAdmin service.
+// All implementations must embed UnimplementedQuotesAdminServer
+// for forward compatibility
+type QuotesAdminServer interface {
+	CreateQuote(context.Context, *CreateQuoteRequest) (*QuoteReply, error)
+	RenameQuote(context.Context, *RenameQuoteRequest) (*QuoteReply, error)
+	// UpdateQuoteMetadata changes the fields that are set, the trading calendar of the quote follows them
+	UpdateQuoteMetadata(context.Context, *UpdateQuoteMetadataRequest) (*QuoteReply, error)
+	SuspendQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error)
+	ResumeQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error)
+	DeleteQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error)
+	// LoadQuote schedules a load job of a new quote, it becomes ready when the job is done
+	LoadQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error)
+	// ListJobs returns the latest ingestion jobs first
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error)
+	// BackfillQuote schedules a job reloading a range of the quote history
+	BackfillQuote(context.Context, *BackfillQuoteRequest) (*JobReply, error)
+	mustEmbedUnimplementedQuotesAdminServer()
+}
+
+// UnimplementedQuotesAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedQuotesAdminServer struct {
+}
+
+func (UnimplementedQuotesAdminServer) CreateQuote(context.Context, *CreateQuoteRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) RenameQuote(context.Context, *RenameQuoteRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) UpdateQuoteMetadata(context.Context, *UpdateQuoteMetadataRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuoteMetadata not implemented")
+}
+func (UnimplementedQuotesAdminServer) SuspendQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) ResumeQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) DeleteQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) LoadQuote(context.Context, *QuoteSymbolRequest) (*QuoteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedQuotesAdminServer) BackfillQuote(context.Context, *BackfillQuoteRequest) (*JobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillQuote not implemented")
+}
+func (UnimplementedQuotesAdminServer) mustEmbedUnimplementedQuotesAdminServer() {}
+
+// UnsafeQuotesAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotesAdminServer will
+// result in compilation errors.
+type UnsafeQuotesAdminServer interface {
+	mustEmbedUnimplementedQuotesAdminServer()
+}
+
+func RegisterQuotesAdminServer(s grpc.ServiceRegistrar, srv QuotesAdminServer) {
+	s.RegisterService(&QuotesAdmin_ServiceDesc, srv)
+}
+
+func _QuotesAdmin_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/CreateQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_RenameQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).RenameQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/RenameQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).RenameQuote(ctx, req.(*RenameQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_UpdateQuoteMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuoteMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).UpdateQuoteMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/UpdateQuoteMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).UpdateQuoteMetadata(ctx, req.(*UpdateQuoteMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_SuspendQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).SuspendQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/SuspendQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).SuspendQuote(ctx, req.(*QuoteSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_ResumeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).ResumeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/ResumeQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).ResumeQuote(ctx, req.(*QuoteSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_DeleteQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).DeleteQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/DeleteQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).DeleteQuote(ctx, req.(*QuoteSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_LoadQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).LoadQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/LoadQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).LoadQuote(ctx, req.(*QuoteSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotesAdmin_BackfillQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotesAdminServer).BackfillQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.QuotesAdmin/BackfillQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotesAdminServer).BackfillQuote(ctx, req.(*BackfillQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuotesAdmin_ServiceDesc is the grpc.ServiceDesc for QuotesAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuotesAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.QuotesAdmin",
+	HandlerType: (*QuotesAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateQuote",
+			Handler:    _QuotesAdmin_CreateQuote_Handler,
+		},
+		{
+			MethodName: "RenameQuote",
+			Handler:    _QuotesAdmin_RenameQuote_Handler,
+		},
+		{
+			MethodName: "UpdateQuoteMetadata",
+			Handler:    _QuotesAdmin_UpdateQuoteMetadata_Handler,
+		},
+		{
+			MethodName: "SuspendQuote",
+			Handler:    _QuotesAdmin_SuspendQuote_Handler,
+		},
+		{
+			MethodName: "ResumeQuote",
+			Handler:    _QuotesAdmin_ResumeQuote_Handler,
+		},
+		{
+			MethodName: "DeleteQuote",
+			Handler:    _QuotesAdmin_DeleteQuote_Handler,
+		},
+		{
+			MethodName: "LoadQuote",
+			Handler:    _QuotesAdmin_LoadQuote_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _QuotesAdmin_ListJobs_Handler,
+		},
+		{
+			MethodName: "BackfillQuote",
+			Handler:    _QuotesAdmin_BackfillQuote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/quotes.proto",
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+)
+
+// CandlestickEvent tells that a candlestick of a finished period is stored.
+// Its prices aren't adjusted, but no later corporate action can change them yet.
+type CandlestickEvent struct {
+	Symbol      string
+	Candlestick candlestick.Candlestick
+}
+
+// CandlestickBus passes the events of the loader to the subscribers of this instance.
+type CandlestickBus interface {
+	// Publish sends the events later than the ones already published for the same quote and interval.
+	Publish(events []CandlestickEvent)
+	// Subscribe returns the events of the symbols in the interval, of all symbols if there are none.
+	// The channel is closed when the subscription is cancelled or the subscriber falls too far behind.
+	Subscribe(symbols []string, interval candlestick.Interval) (events <-chan CandlestickEvent, cancel func())
+}
+
+// subscriptionBuffer is how many events a subscriber may fall behind, a day of hourly bars of a few hundred quotes
+const subscriptionBuffer = 10000
+
+type busKey struct {
+	quoteID  int64
+	interval candlestick.Interval
+}
+
+type subscription struct {
+	symbols  map[string]bool
+	interval candlestick.Interval
+	events   chan CandlestickEvent
+}
+
+type candlestickBus struct {
+	mu            sync.Mutex
+	subscriptions map[*subscription]bool
+	published     map[busKey]time.Time
+}
+
+func NewCandlestickBus() CandlestickBus {
+	return &candlestickBus{
+		subscriptions: make(map[*subscription]bool),
+		published:     make(map[busKey]time.Time),
+	}
+}
+
+func (r *candlestickBus) Publish(events []CandlestickEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, e := range events {
+		key := busKey{e.Candlestick.QuoteID, e.Candlestick.Interval}
+		if !e.Candlestick.Timestamp.After(r.published[key]) {
+			continue
+		}
+		r.published[key] = e.Candlestick.Timestamp
+
+		for s := range r.subscriptions {
+			if s.interval != e.Candlestick.Interval || (len(s.symbols) > 0 && !s.symbols[e.Symbol]) {
+				continue
+			}
+
+			// a subscriber this far behind has to resubscribe and catch up by the history
+			select {
+			case s.events <- e:
+			default:
+				delete(r.subscriptions, s)
+				close(s.events)
+			}
+		}
+	}
+}
+
+func (r *candlestickBus) Subscribe(symbols []string, interval candlestick.Interval) (<-chan CandlestickEvent, func()) {
+	s := &subscription{
+		symbols:  make(map[string]bool, len(symbols)),
+		interval: interval,
+		events:   make(chan CandlestickEvent, subscriptionBuffer),
+	}
+	for _, symbol := range symbols {
+		s.symbols[symbol] = true
+	}
+
+	r.mu.Lock()
+	r.subscriptions[s] = true
+	r.mu.Unlock()
+
+	return s.events, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.subscriptions[s] {
+			delete(r.subscriptions, s)
+			close(s.events)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+)
+
+func hourlyEvent(symbol string, quoteID int64, hour int) CandlestickEvent {
+	return CandlestickEvent{
+		Symbol: symbol,
+		Candlestick: candlestick.Candlestick{
+			QuoteID:   quoteID,
+			Interval:  candlestick.IntervalHour,
+			Timestamp: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(hour) * time.Hour),
+		},
+	}
+}
+
+func TestCandlestickBus_Publish_Dedup(t *testing.T) {
+	bus := NewCandlestickBus()
+	events, cancel := bus.Subscribe([]string{"AAPL"}, candlestick.IntervalHour)
+	defer cancel()
+
+	bus.Publish([]CandlestickEvent{hourlyEvent("AAPL", 1, 10), hourlyEvent("MSFT", 2, 10)})
+	// the same and an earlier candlestick again, then a later one
+	bus.Publish([]CandlestickEvent{hourlyEvent("AAPL", 1, 10), hourlyEvent("AAPL", 1, 9), hourlyEvent("AAPL", 1, 11)})
+
+	var hours []int
+	for len(events) > 0 {
+		e := <-events
+		if e.Symbol != "AAPL" {
+			t.Errorf("unexpected symbol %s", e.Symbol)
+		}
+		hours = append(hours, e.Candlestick.Timestamp.Hour())
+	}
+	if len(hours) != 2 || hours[0] != 10 || hours[1] != 11 {
+		t.Errorf("expected the hours 10 and 11, got %v", hours)
+	}
+}
+
+func TestCandlestickBus_Publish_EvictsSlowSubscriber(t *testing.T) {
+	bus := NewCandlestickBus()
+	slow, cancelSlow := bus.Subscribe(nil, candlestick.IntervalHour)
+	defer cancelSlow()
+
+	events := make([]CandlestickEvent, subscriptionBuffer+1)
+	for i := range events {
+		events[i] = hourlyEvent("AAPL", 1, i)
+	}
+	bus.Publish(events)
+
+	received := 0
+	for range slow {
+		received++
+	}
+	if received != subscriptionBuffer {
+		t.Errorf("expected %d events before the channel is closed, got %d", subscriptionBuffer, received)
+	}
+
+	// a new subscriber gets the next events, the evicted one is gone
+	fresh, cancelFresh := bus.Subscribe(nil, candlestick.IntervalHour)
+	defer cancelFresh()
+	bus.Publish([]CandlestickEvent{hourlyEvent("AAPL", 1, subscriptionBuffer+1)})
+	if len(fresh) != 1 {
+		t.Errorf("expected 1 event, got %d", len(fresh))
+	}
+}
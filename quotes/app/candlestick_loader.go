@@ -6,104 +6,353 @@ import (
 
 	"github.com/go-kit/kit/log"
 
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
 	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
 	"github.com/websmee/example_of_my_code/quotes/domain/quote"
 )
 
-const (
-	startDate = "2018-01-01T00:00:00Z"
-	endDate   = "2021-05-01T00:00:00Z"
-)
-
 type CandlestickLoader interface {
-	LoadCandlesticks() error
-	LoadLatest() error
+	LoadCandlesticks() ([]Coverage, error)
+	LoadQuote(q quote.Quote) ([]Coverage, error)
+	LoadLatest(q quote.Quote) error
+	LoadLatestActions(q quote.Quote) error
+	Backfill(q quote.Quote, from, to time.Time) error
+}
+
+// Coverage tells how complete the stored history of a quote is.
+type Coverage struct {
+	Symbol   string
+	Interval candlestick.Interval
+	Expected int
+	Stored   int
+	Gaps     int
+}
+
+func (r Coverage) Percent() float64 {
+	if r.Expected == 0 || r.Stored >= r.Expected {
+		return 100
+	}
+
+	return float64(r.Stored) / float64(r.Expected) * 100
 }
 
+// latestActionsPeriod is how far back LoadLatest looks for corporate actions, they are announced in advance
+// but the providers may publish them a few days late
+const latestActionsPeriod = 7 * 24 * time.Hour
+
 type candlestickLoader struct {
 	loader          candlestick.Loader
+	actionLoader    action.Loader
 	candlestickRepo candlestick.Repository
+	actionRepo      action.Repository
+	issueRepo       candlestick.IssueRepository
 	quoteRepo       quote.Repository
+	validator       candlestick.Validator
+	gapFinders      candlestick.GapFinderFactory
+	bus             CandlestickBus
+	intervals       []candlestick.Interval
+	historyStart    time.Time
 }
 
 func NewCandlestickLoader(
 	logger log.Logger,
 	loader candlestick.Loader,
+	actionLoader action.Loader,
 	candlestickRepo candlestick.Repository,
+	actionRepo action.Repository,
+	issueRepo candlestick.IssueRepository,
 	quoteRepo quote.Repository,
+	validator candlestick.Validator,
+	gapFinders candlestick.GapFinderFactory,
+	bus CandlestickBus,
+	intervals []candlestick.Interval,
+	historyStart time.Time,
 ) CandlestickLoader {
 	var svc CandlestickLoader
 	{
 		svc = &candlestickLoader{
 			loader:          loader,
+			actionLoader:    actionLoader,
 			candlestickRepo: candlestickRepo,
+			actionRepo:      actionRepo,
+			issueRepo:       issueRepo,
 			quoteRepo:       quoteRepo,
+			validator:       validator,
+			gapFinders:      gapFinders,
+			bus:             bus,
+			intervals:       intervals,
+			historyStart:    historyStart.UTC(),
 		}
 		svc = CandlestickLoaderLoggingMiddleware(logger)(svc)
 	}
 	return svc
 }
 
-func (r candlestickLoader) LoadCandlesticks() error {
-	quotes, err := r.quoteRepo.GetQuotes(quote.StatusNew)
+// LoadCandlesticks brings the history of new and ready quotes up to date and backfills its gaps.
+func (r candlestickLoader) LoadCandlesticks() ([]Coverage, error) {
+	newQuotes, err := r.quoteRepo.GetQuotes(quote.StatusNew)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, q := range quotes {
-		if err := r.load(q, startDate, endDate, candlestick.IntervalHour); err != nil {
-			return err
+	readyQuotes, err := r.quoteRepo.GetQuotes(quote.StatusReady)
+	if err != nil {
+		return nil, err
+	}
+
+	var coverage []Coverage
+	for _, q := range append(newQuotes, readyQuotes...) {
+		c, err := r.LoadQuote(q)
+		if err != nil {
+			return nil, err
 		}
-		fmt.Println("READY", q)
+		coverage = append(coverage, c...)
 	}
 
-	return nil
+	return coverage, nil
 }
 
-func (r candlestickLoader) LoadLatest() error {
-	quotes, err := r.quoteRepo.GetQuotes(quote.StatusReady)
-	if err != nil {
-		return err
+// LoadQuote loads the history of one quote with its corporate actions, a new quote becomes ready after that.
+func (r candlestickLoader) LoadQuote(q quote.Quote) ([]Coverage, error) {
+	var coverage []Coverage
+	for _, interval := range r.intervals {
+		c, err := r.load(q, interval)
+		if err != nil {
+			return nil, err
+		}
+		coverage = append(coverage, c)
+	}
+
+	if err := r.loadActions(q, r.historyStart, time.Now().UTC()); err != nil {
+		return nil, err
 	}
 
-	for _, q := range quotes {
-		cs, err := r.loader.LoadLatest(q, candlestick.IntervalHour)
+	if q.Status == quote.StatusNew {
+		if err := r.quoteRepo.UpdateQuoteStatus(&q, quote.StatusReady); err != nil {
+			return nil, err
+		}
+		fmt.Println("READY", q)
+	}
+
+	return coverage, nil
+}
+
+// LoadLatest loads the candlesticks of the quote appeared since the last stored ones,
+// the ones of finished periods are published to the bus.
+func (r candlestickLoader) LoadLatest(q quote.Quote) error {
+	for _, interval := range r.intervals {
+		last, err := r.candlestickRepo.GetLastCandlestickTimestamp(&q, interval)
 		if err != nil {
 			return err
 		}
 
-		for i := range cs {
-			err := r.candlestickRepo.SaveCandlestick(&cs[i])
-			if err != nil {
-				return err
-			}
+		var cs []candlestick.Candlestick
+		if last.IsZero() {
+			cs, err = r.loader.LoadLatest(q, interval)
+		} else {
+			cs, err = r.loader.LoadHistory(q, last, time.Now().UTC(), interval)
 		}
+		if err != nil {
+			return err
+		}
+
+		saved, err := r.save(q, interval, cs)
+		if err != nil {
+			return err
+		}
+		r.publishClosed(q, interval, saved)
 	}
 
 	return nil
 }
 
-func (r candlestickLoader) load(q quote.Quote, startDate string, endDate string, interval candlestick.Interval) error {
-	start, _ := time.Parse(time.RFC3339, startDate)
-	end, _ := time.Parse(time.RFC3339, endDate)
-	start = start.UTC()
-	end = end.UTC()
+// LoadLatestActions loads the corporate actions of the quote announced or published lately.
+func (r candlestickLoader) LoadLatestActions(q quote.Quote) error {
+	now := time.Now().UTC()
+	return r.loadActions(q, now.Add(-latestActionsPeriod), now)
+}
 
-	cs, err := r.loader.LoadHistory(q, start, end, interval)
+// Backfill reloads the range of the quote history in every interval, the loaded candlesticks overwrite the stored ones,
+// the stored ones the provider doesn't return anymore are kept.
+func (r candlestickLoader) Backfill(q quote.Quote, from, to time.Time) error {
+	for _, interval := range r.intervals {
+		if err := r.loadRange(q, from, to, interval); err != nil {
+			return err
+		}
+	}
+
+	return r.loadActions(q, from, to)
+}
+
+func (r candlestickLoader) load(q quote.Quote, interval candlestick.Interval) (Coverage, error) {
+	end := time.Now().UTC()
+
+	gapFinder, err := r.gapFinders(q)
 	if err != nil {
-		return err
+		return Coverage{}, err
 	}
 
-	for i := range cs {
-		err := r.candlestickRepo.SaveCandlestick(&cs[i])
+	// resume from the last stored candlestick, it could have been saved before its period ended
+	start, err := r.candlestickRepo.GetLastCandlestickTimestamp(&q, interval)
+	if err != nil {
+		return Coverage{}, err
+	}
+	if start.Before(r.historyStart) {
+		start = r.historyStart
+	}
+
+	if err := r.loadRange(q, start, end, interval); err != nil {
+		return Coverage{}, err
+	}
+
+	timestamps, gaps, err := r.findGaps(q, gapFinder, interval, end)
+	if err != nil {
+		return Coverage{}, err
+	}
+
+	if len(gaps) > 0 {
+		for _, gap := range gaps {
+			if err := r.loadRange(q, gap.From, gap.To, interval); err != nil {
+				return Coverage{}, err
+			}
+		}
+
+		timestamps, gaps, err = r.findGaps(q, gapFinder, interval, end)
 		if err != nil {
-			return err
+			return Coverage{}, err
+		}
+	}
+
+	return Coverage{
+		Symbol:   q.Symbol,
+		Interval: interval,
+		Expected: gapFinder.CountExpected(interval, r.historyStart, end),
+		Stored:   len(timestamps),
+		Gaps:     len(gaps),
+	}, nil
+}
+
+func (r candlestickLoader) findGaps(q quote.Quote, gapFinder candlestick.GapFinder, interval candlestick.Interval, end time.Time) ([]time.Time, []candlestick.Gap, error) {
+	timestamps, err := r.candlestickRepo.GetCandlestickTimestamps(&q, interval, r.historyStart, end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the quarantined candlesticks were loaded already, reloading them would only spend the provider's requests
+	quarantined, err := r.issueRepo.GetQuarantinedTimestamps(&q, interval, r.historyStart, end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return timestamps, gapFinder.FindGaps(mergeTimestamps(timestamps, quarantined), interval, r.historyStart, end), nil
+}
+
+// mergeTimestamps merges the ordered timestamps into one ordered list without duplicates
+func mergeTimestamps(a, b []time.Time) []time.Time {
+	merged := make([]time.Time, 0, len(a)+len(b))
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		var next time.Time
+		if j == len(b) || i < len(a) && a[i].Before(b[j]) {
+			next, i = a[i], i+1
+		} else {
+			next, j = b[j], j+1
+		}
+		if len(merged) == 0 || !merged[len(merged)-1].Equal(next) {
+			merged = append(merged, next)
 		}
 	}
 
-	if err := r.quoteRepo.UpdateQuoteStatus(&q, quote.StatusReady); err != nil {
+	return merged
+}
+
+func (r candlestickLoader) loadRange(q quote.Quote, start, end time.Time, interval candlestick.Interval) error {
+	cs, err := r.loader.LoadHistory(q, start, end, interval)
+	if err != nil {
 		return err
 	}
 
+	saved, err := r.save(q, interval, cs)
+	if err != nil {
+		return err
+	}
+	r.publishLastClosed(q, interval, saved)
+
 	return nil
 }
+
+func (r candlestickLoader) loadActions(q quote.Quote, start, end time.Time) error {
+	actions, err := r.actionLoader.LoadActions(q, start, end)
+	if err != nil {
+		return err
+	}
+
+	return r.actionRepo.SaveActions(actions)
+}
+
+// save validates the candlesticks against each other and the last stored one before them, the issues are saved too.
+// It returns the saved candlesticks.
+func (r candlestickLoader) save(q quote.Quote, interval candlestick.Interval, cs []candlestick.Candlestick) ([]candlestick.Candlestick, error) {
+	if len(cs) == 0 {
+		return nil, nil
+	}
+
+	var previous *candlestick.Candlestick
+	first := cs[0].Timestamp
+	for i := range cs {
+		if cs[i].Timestamp.Before(first) {
+			first = cs[i].Timestamp
+		}
+	}
+	stored, err := r.candlestickRepo.GetCandlesticksByCount(&q, interval, first.Add(-time.Second), candlestick.DirectionBackward, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) > 0 {
+		previous = &stored[0]
+	}
+
+	cs, issues := r.validator.Validate(q, cs, previous)
+	if err := r.issueRepo.SaveIssues(issues); err != nil {
+		return nil, err
+	}
+
+	if err := r.candlestickRepo.SaveCandlesticks(cs); err != nil {
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+// publishClosed leaves out the last candlestick while its period lasts, it is published by a later load
+func (r candlestickLoader) publishClosed(q quote.Quote, interval candlestick.Interval, cs []candlestick.Candlestick) {
+	now := time.Now().UTC()
+	var events []CandlestickEvent
+	for i := range cs {
+		if cs[i].Timestamp.Add(interval.Duration()).After(now) {
+			continue
+		}
+		events = append(events, CandlestickEvent{Symbol: q.Symbol, Candlestick: cs[i]})
+	}
+
+	r.bus.Publish(events)
+}
+
+// publishLastClosed publishes only the latest closed candlestick of a reloaded range,
+// the history before it is no news to the subscribers
+func (r candlestickLoader) publishLastClosed(q quote.Quote, interval candlestick.Interval, cs []candlestick.Candlestick) {
+	now := time.Now().UTC()
+	var last *candlestick.Candlestick
+	for i := range cs {
+		if cs[i].Timestamp.Add(interval.Duration()).After(now) {
+			continue
+		}
+		if last == nil || cs[i].Timestamp.After(last.Timestamp) {
+			last = &cs[i]
+		}
+	}
+	if last == nil {
+		return
+	}
+
+	r.bus.Publish([]CandlestickEvent{{Symbol: q.Symbol, Candlestick: *last}})
+}
@@ -1,7 +1,11 @@
 package app
 
 import (
+	"time"
+
 	"github.com/go-kit/kit/log"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
 )
 
 type CandlestickLoaderMiddleware func(service CandlestickLoader) CandlestickLoader
@@ -17,18 +21,42 @@ type candlestickLoaderLoggingMiddleware struct {
 	next   CandlestickLoader
 }
 
-func (mw candlestickLoaderLoggingMiddleware) LoadCandlesticks() (err error) {
+func (mw candlestickLoaderLoggingMiddleware) LoadCandlesticks() (coverage []Coverage, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "LoadCandlesticks", "loaded", len(coverage), "error", err)
+	}()
+	coverage, err = mw.next.LoadCandlesticks()
+	return
+}
+
+func (mw candlestickLoaderLoggingMiddleware) LoadQuote(q quote.Quote) (coverage []Coverage, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "LoadQuote", "symbol", q.Symbol, "loaded", len(coverage), "error", err)
+	}()
+	coverage, err = mw.next.LoadQuote(q)
+	return
+}
+
+func (mw candlestickLoaderLoggingMiddleware) LoadLatest(q quote.Quote) (err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "LoadLatest", "symbol", q.Symbol, "error", err)
+	}()
+	err = mw.next.LoadLatest(q)
+	return
+}
+
+func (mw candlestickLoaderLoggingMiddleware) LoadLatestActions(q quote.Quote) (err error) {
 	defer func() {
-		_ = mw.logger.Log("method", "LoadCandlesticks", "error", err)
+		_ = mw.logger.Log("method", "LoadLatestActions", "symbol", q.Symbol, "error", err)
 	}()
-	err = mw.next.LoadCandlesticks()
+	err = mw.next.LoadLatestActions(q)
 	return
 }
 
-func (mw candlestickLoaderLoggingMiddleware) LoadLatest() (err error) {
+func (mw candlestickLoaderLoggingMiddleware) Backfill(q quote.Quote, from, to time.Time) (err error) {
 	defer func() {
-		_ = mw.logger.Log("method", "LoadLatest", "error", err)
+		_ = mw.logger.Log("method", "Backfill", "symbol", q.Symbol, "from", from, "to", to, "error", err)
 	}()
-	err = mw.next.LoadLatest()
+	err = mw.next.Backfill(q, from, to)
 	return
 }
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMergeTimestamps(t *testing.T) {
+	at := func(hours ...int) []time.Time {
+		timestamps := make([]time.Time, 0, len(hours))
+		for _, h := range hours {
+			timestamps = append(timestamps, time.Date(2021, 3, 1, h, 0, 0, 0, time.UTC))
+		}
+		return timestamps
+	}
+
+	stored := at(9, 10, 12, 15)
+	quarantined := at(11, 12, 16)
+
+	merged := mergeTimestamps(stored, quarantined)
+	expected := at(9, 10, 11, 12, 15, 16)
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d timestamps, got %d: %v", len(expected), len(merged), merged)
+	}
+	for i := range expected {
+		if !merged[i].Equal(expected[i]) {
+			t.Errorf("timestamp %d: expected %s, got %s", i, expected[i], merged[i])
+		}
+	}
+
+	if merged := mergeTimestamps(stored, nil); len(merged) != len(stored) {
+		t.Errorf("expected the stored timestamps only, got %v", merged)
+	}
+}
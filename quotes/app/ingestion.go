@@ -0,0 +1,287 @@
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type IngestionApp interface {
+	// ScheduleLatest creates a latest job for every ready quote without an unfinished one.
+	ScheduleLatest() ([]job.Job, error)
+	// ScheduleActions creates an actions job for every ready quote without an unfinished one.
+	ScheduleActions() ([]job.Job, error)
+	ScheduleBackfill(symbol string, from, to time.Time) (*job.Job, error)
+	// ScheduleLoad creates a load job of a new quote, it returns the unfinished one if there is one already.
+	ScheduleLoad(symbol string) (*job.Job, error)
+	// RunDueJob runs the earliest due job, it returns nil if there are none.
+	RunDueJob() (*job.Job, error)
+	// ResetStaleJobs retries the running jobs that stopped heartbeating, their worker has gone.
+	// The ones having no attempts left fail.
+	ResetStaleJobs() (int, error)
+	ListJobs(filter JobFilter) ([]job.Job, error)
+}
+
+// JobFilter is the job.Filter of the API, with the quote by symbol.
+type JobFilter struct {
+	Type     job.Type
+	Statuses []job.Status
+	Symbol   string
+	Limit    int
+}
+
+const (
+	defaultJobsLimit = 100
+	maxJobsLimit     = 1000
+)
+
+type ingestionApp struct {
+	loader       CandlestickLoader
+	jobRepo      job.Repository
+	quoteRepo    quote.Repository
+	maxAttempts  int
+	retryBackoff time.Duration
+	jobLease     time.Duration
+}
+
+func NewIngestionApp(
+	logger log.Logger,
+	loader CandlestickLoader,
+	jobRepo job.Repository,
+	quoteRepo quote.Repository,
+	maxAttempts int,
+	retryBackoff time.Duration,
+	jobLease time.Duration,
+) IngestionApp {
+	var svc IngestionApp
+	{
+		svc = &ingestionApp{
+			loader:       loader,
+			jobRepo:      jobRepo,
+			quoteRepo:    quoteRepo,
+			maxAttempts:  maxAttempts,
+			retryBackoff: retryBackoff,
+			jobLease:     jobLease,
+		}
+		svc = IngestionLoggingMiddleware(logger)(svc)
+	}
+	return svc
+}
+
+func (r ingestionApp) ScheduleLatest() ([]job.Job, error) {
+	return r.scheduleReady(job.TypeLatest)
+}
+
+func (r ingestionApp) ScheduleActions() ([]job.Job, error) {
+	return r.scheduleReady(job.TypeActions)
+}
+
+func (r ingestionApp) scheduleReady(t job.Type) ([]job.Job, error) {
+	quotes, err := r.quoteRepo.GetQuotes(quote.StatusReady)
+	if err != nil {
+		return nil, err
+	}
+
+	// a quote still catching up doesn't need another job, the running one loads everything up to its end
+	unfinished, err := r.jobRepo.FindJobs(job.Filter{
+		Type:     t,
+		Statuses: []job.Status{job.StatusPending, job.StatusRunning},
+	})
+	if err != nil {
+		return nil, err
+	}
+	busy := make(map[int64]bool, len(unfinished))
+	for i := range unfinished {
+		busy[unfinished[i].QuoteID] = true
+	}
+
+	var jobs []job.Job
+	now := time.Now().UTC()
+	for _, q := range quotes {
+		if busy[q.ID] {
+			continue
+		}
+
+		j := job.NewJob(t, q.ID, q.Symbol, r.maxAttempts, now)
+		if err := r.jobRepo.CreateJob(&j); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
+func (r ingestionApp) ScheduleBackfill(symbol string, from, to time.Time) (*job.Job, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status == quote.StatusSuspended {
+		return nil, errors.Errorf("quote %s is suspended", symbol)
+	}
+
+	now := time.Now().UTC()
+	if to.IsZero() || to.After(now) {
+		to = now
+	}
+	if !from.Before(to) {
+		return nil, errors.New("from must be before to")
+	}
+
+	j := job.NewJob(job.TypeBackfill, q.ID, q.Symbol, r.maxAttempts, now)
+	j.From = from.UTC()
+	j.To = to.UTC()
+	if err := r.jobRepo.CreateJob(&j); err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+func (r ingestionApp) ScheduleLoad(symbol string) (*job.Job, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status != quote.StatusNew {
+		return nil, errors.Errorf("quote %s isn't new", symbol)
+	}
+
+	unfinished, err := r.jobRepo.FindJobs(job.Filter{
+		Type:     job.TypeLoad,
+		Statuses: []job.Status{job.StatusPending, job.StatusRunning},
+		QuoteID:  q.ID,
+		Limit:    1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(unfinished) > 0 {
+		return &unfinished[0], nil
+	}
+
+	j := job.NewJob(job.TypeLoad, q.ID, q.Symbol, r.maxAttempts, time.Now().UTC())
+	if err := r.jobRepo.CreateJob(&j); err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+func (r ingestionApp) RunDueJob() (*job.Job, error) {
+	j, err := r.jobRepo.TakeDueJob(time.Now().UTC())
+	if err != nil || j == nil {
+		return nil, err
+	}
+
+	q, err := r.quoteRepo.GetQuoteByID(j.QuoteID)
+	switch {
+	case err != nil:
+		j.Fail(err, time.Now().UTC(), r.retryBackoff)
+	case q.ID == 0:
+		j.Abort(errors.Errorf("quote %s not found", j.Symbol), time.Now().UTC())
+	case q.Status == quote.StatusSuspended:
+		j.Abort(errors.Errorf("quote %s is suspended", q.Symbol), time.Now().UTC())
+	default:
+		if err := r.runWithHeartbeat(j, *q); err != nil {
+			j.Fail(err, time.Now().UTC(), r.retryBackoff)
+		} else {
+			j.Succeed(time.Now().UTC())
+		}
+	}
+
+	if err := r.jobRepo.UpdateJob(j); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (r ingestionApp) ResetStaleJobs() (int, error) {
+	now := time.Now().UTC()
+	return r.jobRepo.ResetStaleJobs(now.Add(-r.jobLease), now)
+}
+
+// runWithHeartbeat renews the heartbeat a few times per lease, so a slow job isn't taken for an abandoned one
+func (r ingestionApp) runWithHeartbeat(j *job.Job, q quote.Quote) error {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		heartbeat := *j
+		ticker := time.NewTicker(r.jobLease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				// a missed heartbeat is made up by the next one, the lease outlasts a few of them
+				_ = r.jobRepo.HeartbeatJob(&heartbeat, time.Now().UTC())
+			case <-done:
+				return
+			}
+		}
+	}()
+	defer wg.Wait()
+	defer close(done)
+
+	return r.run(*j, q)
+}
+
+func (r ingestionApp) run(j job.Job, q quote.Quote) error {
+	switch j.Type {
+	case job.TypeLatest:
+		return r.loader.LoadLatest(q)
+	case job.TypeActions:
+		return r.loader.LoadLatestActions(q)
+	case job.TypeBackfill:
+		return r.loader.Backfill(q, j.From, j.To)
+	case job.TypeLoad:
+		_, err := r.loader.LoadQuote(q)
+		return err
+	default:
+		return errors.Errorf("unknown job type %q", j.Type)
+	}
+}
+
+func (r ingestionApp) ListJobs(filter JobFilter) ([]job.Job, error) {
+	f := job.Filter{
+		Type:     filter.Type,
+		Statuses: filter.Statuses,
+		Limit:    filter.Limit,
+	}
+	if f.Limit <= 0 {
+		f.Limit = defaultJobsLimit
+	}
+	if f.Limit > maxJobsLimit {
+		f.Limit = maxJobsLimit
+	}
+
+	if filter.Symbol != "" {
+		q, err := r.getQuote(filter.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		f.QuoteID = q.ID
+	}
+
+	return r.jobRepo.FindJobs(f)
+}
+
+func (r ingestionApp) getQuote(symbol string) (*quote.Quote, error) {
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.ID == 0 {
+		return nil, errors.Errorf("quote %s not found", symbol)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
+)
+
+type IngestionMiddleware func(service IngestionApp) IngestionApp
+
+func IngestionLoggingMiddleware(logger log.Logger) IngestionMiddleware {
+	return func(next IngestionApp) IngestionApp {
+		return ingestionLoggingMiddleware{logger, next}
+	}
+}
+
+type ingestionLoggingMiddleware struct {
+	logger log.Logger
+	next   IngestionApp
+}
+
+func (mw ingestionLoggingMiddleware) ScheduleLatest() (jobs []job.Job, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ScheduleLatest", "scheduled", len(jobs), "error", err)
+	}()
+	return mw.next.ScheduleLatest()
+}
+
+func (mw ingestionLoggingMiddleware) ScheduleActions() (jobs []job.Job, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ScheduleActions", "scheduled", len(jobs), "error", err)
+	}()
+	return mw.next.ScheduleActions()
+}
+
+func (mw ingestionLoggingMiddleware) ScheduleBackfill(symbol string, from, to time.Time) (j *job.Job, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ScheduleBackfill", "symbol", symbol, "from", from, "to", to, "error", err)
+	}()
+	return mw.next.ScheduleBackfill(symbol, from, to)
+}
+
+func (mw ingestionLoggingMiddleware) ScheduleLoad(symbol string) (j *job.Job, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ScheduleLoad", "symbol", symbol, "error", err)
+	}()
+	return mw.next.ScheduleLoad(symbol)
+}
+
+// RunDueJob logs only the runs that took a job, the workers poll it all the time
+func (mw ingestionLoggingMiddleware) RunDueJob() (j *job.Job, err error) {
+	defer func() {
+		if j != nil {
+			_ = mw.logger.Log("method", "RunDueJob", "job", j.ID, "type", j.Type, "symbol", j.Symbol,
+				"attempt", j.Attempts, "status", j.Status, "job_error", j.Error, "error", err)
+		} else if err != nil {
+			_ = mw.logger.Log("method", "RunDueJob", "error", err)
+		}
+	}()
+	return mw.next.RunDueJob()
+}
+
+func (mw ingestionLoggingMiddleware) ListJobs(filter JobFilter) (jobs []job.Job, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ListJobs", "symbol", filter.Symbol, "listed", len(jobs), "error", err)
+	}()
+	return mw.next.ListJobs(filter)
+}
+
+func (mw ingestionLoggingMiddleware) ResetStaleJobs() (n int, err error) {
+	defer func() {
+		if n > 0 || err != nil {
+			_ = mw.logger.Log("method", "ResetStaleJobs", "reset", n, "error", err)
+		}
+	}()
+	return mw.next.ResetStaleJobs()
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"regexp"
+
+	"github.com/go-kit/kit/log"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type QuoteManagerApp interface {
+	CreateQuote(q quote.Quote) (*quote.Quote, error)
+	RenameQuote(symbol, newSymbol, newName string) (*quote.Quote, error)
+	UpdateQuoteMetadata(symbol string, metadata quote.Quote) (*quote.Quote, error)
+	SuspendQuote(symbol string) (*quote.Quote, error)
+	ResumeQuote(symbol string) (*quote.Quote, error)
+	DeleteQuote(symbol string) (*quote.Quote, error)
+	LoadQuote(symbol string) (*quote.Quote, error)
+}
+
+var symbolRegexp = regexp.MustCompile(`^[A-Z0-9.=^_-]{1,20}$`)
+
+type quoteManagerApp struct {
+	quoteRepo       quote.Repository
+	ingestion       IngestionApp
+	providers       map[string]bool
+	defaultProvider string
+}
+
+func NewQuoteManagerApp(
+	logger log.Logger,
+	quoteRepo quote.Repository,
+	ingestion IngestionApp,
+	providers []string,
+	defaultProvider string,
+) QuoteManagerApp {
+	knownProviders := make(map[string]bool, len(providers))
+	for i := range providers {
+		knownProviders[providers[i]] = true
+	}
+
+	var svc QuoteManagerApp
+	{
+		svc = &quoteManagerApp{
+			quoteRepo:       quoteRepo,
+			ingestion:       ingestion,
+			providers:       knownProviders,
+			defaultProvider: defaultProvider,
+		}
+		svc = QuoteManagerLoggingMiddleware(logger)(svc)
+	}
+	return svc
+}
+
+// CreateQuote adds a new quote, its history isn't loaded until LoadQuote or the next loader run.
+// An empty provider is the configured default one, empty metadata fields get the defaults of a US stock.
+func (r quoteManagerApp) CreateQuote(q quote.Quote) (*quote.Quote, error) {
+	if err := r.validateSymbol(q.Symbol); err != nil {
+		return nil, err
+	}
+	if q.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if q.Provider == "" {
+		q.Provider = r.defaultProvider
+	}
+	if !r.providers[q.Provider] {
+		return nil, errors.Errorf("unknown provider %q", q.Provider)
+	}
+	if err := validateMetadata(q); err != nil {
+		return nil, err
+	}
+
+	q.ID = 0
+	q.Status = quote.StatusNew
+	if err := r.quoteRepo.CreateQuote(&q); err != nil {
+		return nil, err
+	}
+
+	return &q, nil
+}
+
+func (r quoteManagerApp) RenameQuote(symbol, newSymbol, newName string) (*quote.Quote, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if newSymbol != "" && newSymbol != q.Symbol {
+		if err := r.validateSymbol(newSymbol); err != nil {
+			return nil, err
+		}
+		q.Symbol = newSymbol
+	}
+	if newName != "" {
+		q.Name = newName
+	}
+
+	if err := r.quoteRepo.UpdateQuote(q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// UpdateQuoteMetadata sets the non-empty exchange, currency, asset class, timezone and trading hours of the metadata,
+// the loader finds the gaps by the new trading calendar from its next run.
+func (r quoteManagerApp) UpdateQuoteMetadata(symbol string, metadata quote.Quote) (*quote.Quote, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	if metadata.Exchange != "" {
+		q.Exchange = metadata.Exchange
+	}
+	if metadata.Currency != "" {
+		q.Currency = metadata.Currency
+	}
+	if metadata.AssetClass != "" {
+		q.AssetClass = metadata.AssetClass
+	}
+	if metadata.Timezone != "" {
+		q.Timezone = metadata.Timezone
+	}
+	if metadata.TradingHours != "" {
+		q.TradingHours = metadata.TradingHours
+	}
+
+	if err := r.quoteRepo.UpdateQuote(q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (r quoteManagerApp) SuspendQuote(symbol string) (*quote.Quote, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status == quote.StatusSuspended {
+		return nil, errors.Errorf("quote %s is already suspended", symbol)
+	}
+
+	if err := r.quoteRepo.UpdateQuoteStatus(q, quote.StatusSuspended); err != nil {
+		return nil, err
+	}
+	q.Status = quote.StatusSuspended
+
+	return q, nil
+}
+
+// ResumeQuote makes a suspended quote new again, so the loader backfills the history missed meanwhile.
+func (r quoteManagerApp) ResumeQuote(symbol string) (*quote.Quote, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status != quote.StatusSuspended {
+		return nil, errors.Errorf("quote %s isn't suspended", symbol)
+	}
+
+	if err := r.quoteRepo.UpdateQuoteStatus(q, quote.StatusNew); err != nil {
+		return nil, err
+	}
+	q.Status = quote.StatusNew
+
+	return q, nil
+}
+
+func (r quoteManagerApp) DeleteQuote(symbol string) (*quote.Quote, error) {
+	q, err := r.getQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status == quote.StatusReady {
+		return nil, errors.Errorf("quote %s is ready, suspend it first", symbol)
+	}
+
+	if err := r.quoteRepo.DeleteQuote(q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// LoadQuote schedules loading the history of a new quote, the quote becomes ready when the load job is done.
+func (r quoteManagerApp) LoadQuote(symbol string) (*quote.Quote, error) {
+	if _, err := r.ingestion.ScheduleLoad(symbol); err != nil {
+		return nil, err
+	}
+
+	return r.getQuote(symbol)
+}
+
+func (r quoteManagerApp) getQuote(symbol string) (*quote.Quote, error) {
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.ID == 0 {
+		return nil, errors.Errorf("quote %s not found", symbol)
+	}
+
+	return q, nil
+}
+
+func validateMetadata(q quote.Quote) error {
+	if q.AssetClass != "" && !quote.IsKnownAssetClass(q.AssetClass) {
+		return errors.Errorf("unknown asset class %q", q.AssetClass)
+	}
+	if q.Timezone != "" {
+		if _, err := q.GetLocation(); err != nil {
+			return err
+		}
+	}
+	if q.TradingHours != "" {
+		if _, _, err := q.GetTradingHours(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r quoteManagerApp) validateSymbol(symbol string) error {
+	if !symbolRegexp.MatchString(symbol) {
+		return errors.Errorf("invalid symbol %q", symbol)
+	}
+
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return err
+	}
+	if q.ID != 0 {
+		return errors.Errorf("quote %s already exists", symbol)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"github.com/go-kit/kit/log"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type QuoteManagerMiddleware func(service QuoteManagerApp) QuoteManagerApp
+
+func QuoteManagerLoggingMiddleware(logger log.Logger) QuoteManagerMiddleware {
+	return func(next QuoteManagerApp) QuoteManagerApp {
+		return quoteManagerLoggingMiddleware{logger, next}
+	}
+}
+
+type quoteManagerLoggingMiddleware struct {
+	logger log.Logger
+	next   QuoteManagerApp
+}
+
+func (mw quoteManagerLoggingMiddleware) CreateQuote(q quote.Quote) (created *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "CreateQuote", "symbol", q.Symbol, "provider", q.Provider, "error", err)
+	}()
+	return mw.next.CreateQuote(q)
+}
+
+func (mw quoteManagerLoggingMiddleware) RenameQuote(symbol, newSymbol, newName string) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "RenameQuote", "symbol", symbol, "new_symbol", newSymbol, "error", err)
+	}()
+	return mw.next.RenameQuote(symbol, newSymbol, newName)
+}
+
+func (mw quoteManagerLoggingMiddleware) UpdateQuoteMetadata(symbol string, metadata quote.Quote) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log(
+			"method", "UpdateQuoteMetadata",
+			"symbol", symbol,
+			"exchange", metadata.Exchange,
+			"timezone", metadata.Timezone,
+			"trading_hours", metadata.TradingHours,
+			"error", err,
+		)
+	}()
+	return mw.next.UpdateQuoteMetadata(symbol, metadata)
+}
+
+func (mw quoteManagerLoggingMiddleware) SuspendQuote(symbol string) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "SuspendQuote", "symbol", symbol, "error", err)
+	}()
+	return mw.next.SuspendQuote(symbol)
+}
+
+func (mw quoteManagerLoggingMiddleware) ResumeQuote(symbol string) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "ResumeQuote", "symbol", symbol, "error", err)
+	}()
+	return mw.next.ResumeQuote(symbol)
+}
+
+func (mw quoteManagerLoggingMiddleware) DeleteQuote(symbol string) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "DeleteQuote", "symbol", symbol, "error", err)
+	}()
+	return mw.next.DeleteQuote(symbol)
+}
+
+func (mw quoteManagerLoggingMiddleware) LoadQuote(symbol string) (q *quote.Quote, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "LoadQuote", "symbol", symbol, "error", err)
+	}()
+	return mw.next.LoadQuote(symbol)
+}
@@ -2,27 +2,46 @@ package app
 
 import (
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics"
 
+	"github.com/websmee/example_of_my_code/calendar"
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
 	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
 	"github.com/websmee/example_of_my_code/quotes/domain/quote"
 )
 
 type QuotesApp interface {
-	GetQuotes() ([]quote.Quote, error)
-	GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error)
+	// GetQuotes returns the ready quotes matching the filter, its status is ignored.
+	GetQuotes(filter quote.Filter) ([]quote.Quote, error)
+	// GetCandlesticks returns raw prices unless adjusted is set, AdjClose is adjusted by splits and dividends either way.
+	GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time, adjusted bool) ([]candlestick.Candlestick, error)
+	GetCandlesticksBatch(symbols []string, interval candlestick.Interval, from, to time.Time, adjusted bool) (map[string][]candlestick.Candlestick, error)
+	// StreamCandlesticks passes the candlesticks of GetCandlesticks to send page by page as they are read.
+	StreamCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time, adjusted bool, pageSize int, send func([]candlestick.Candlestick) error) error
+	// GetCandlesticksByCount returns exactly count candlesticks next to the start unless the history is shorter.
+	GetCandlesticksByCount(symbol string, interval candlestick.Interval, start time.Time, direction candlestick.Direction, count int, adjusted bool) ([]candlestick.Candlestick, error)
+	// GetCandlestickIssues returns what the validation found in the loaded candlesticks, quarantined ones included.
+	GetCandlestickIssues(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Issue, error)
 	HealthCheck() bool
 }
 
-const healthCheckQuoteSymbol = "AAPL"
+const (
+	healthCheckQuoteSymbol = "AAPL"
+	resampleSourceInterval = candlestick.IntervalHour
+	maxCandlesticksCount   = 10000
+)
 
 type quotesApp struct {
 	counter         metrics.Counter
 	quoteRepo       quote.Repository
 	candlestickRepo candlestick.Repository
+	actionRepo      action.Repository
+	issueRepo       candlestick.IssueRepository
+	resampler       candlestick.Resampler
 }
 
 func NewQuotesApp(
@@ -30,6 +49,9 @@ func NewQuotesApp(
 	counter metrics.Counter,
 	quoteRepo quote.Repository,
 	candlestickRepo candlestick.Repository,
+	actionRepo action.Repository,
+	issueRepo candlestick.IssueRepository,
+	resampler candlestick.Resampler,
 ) QuotesApp {
 	var svc QuotesApp
 	{
@@ -37,6 +59,9 @@ func NewQuotesApp(
 			counter:         counter,
 			quoteRepo:       quoteRepo,
 			candlestickRepo: candlestickRepo,
+			actionRepo:      actionRepo,
+			issueRepo:       issueRepo,
+			resampler:       resampler,
 		}
 		svc = QuotesLoggingMiddleware(logger)(svc)
 		svc = QuotesInstrumentingMiddleware(counter)(svc)
@@ -44,11 +69,12 @@ func NewQuotesApp(
 	return svc
 }
 
-func (r quotesApp) GetQuotes() ([]quote.Quote, error) {
-	return r.quoteRepo.GetQuotes(quote.StatusReady)
+func (r quotesApp) GetQuotes(filter quote.Filter) ([]quote.Quote, error) {
+	filter.Status = quote.StatusReady
+	return r.quoteRepo.FindQuotes(filter)
 }
 
-func (r quotesApp) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error) {
+func (r quotesApp) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time, adjusted bool) ([]candlestick.Candlestick, error) {
 	q, err := r.quoteRepo.GetQuote(symbol)
 	if err != nil {
 		return nil, err
@@ -57,7 +83,376 @@ func (r quotesApp) GetCandlesticks(symbol string, interval candlestick.Interval,
 		return nil, errors.New("the quote isn't ready")
 	}
 
-	return r.candlestickRepo.GetCandlesticks(q, interval, from, to)
+	cs, err := r.candlestickRepo.GetCandlesticks(q, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(cs) == 0 && r.resampler.CanResample(resampleSourceInterval, interval) {
+		return r.getResampledCandlesticks(q, interval, from, to, adjusted)
+	}
+
+	return r.adjust(q, cs, adjusted)
+}
+
+// GetCandlesticksBatch reads the candlesticks of all the symbols at once, symbols having none are resampled at once too.
+func (r quotesApp) GetCandlesticksBatch(symbols []string, interval candlestick.Interval, from, to time.Time, adjusted bool) (map[string][]candlestick.Candlestick, error) {
+	quotes, err := r.getReadyQuotes(symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := r.candlestickRepo.GetCandlesticksBatch(quotes, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := groupBySymbol(quotes, cs)
+	var missing []quote.Quote
+	for i := range quotes {
+		symbol := quotes[i].Symbol
+		if len(result[symbol]) == 0 {
+			missing = append(missing, quotes[i])
+			continue
+		}
+		if result[symbol], err = r.adjust(&quotes[i], result[symbol], adjusted); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(missing) > 0 && r.resampler.CanResample(resampleSourceInterval, interval) {
+		// the source is read from the earliest start of the period of from among the quotes, so no first bucket is cut
+		sourceFrom := from
+		calendars := make(map[string]calendar.Calendar, len(missing))
+		for i := range missing {
+			c, err := missing[i].GetCalendar()
+			if err != nil {
+				return nil, err
+			}
+			calendars[missing[i].Symbol] = c
+			if start := startOfPeriod(from.In(c.Location()), interval); start.Before(sourceFrom) {
+				sourceFrom = start
+			}
+		}
+
+		source, err := r.candlestickRepo.GetCandlesticksBatch(missing, resampleSourceInterval, sourceFrom, to)
+		if err != nil {
+			return nil, err
+		}
+
+		bySymbol := groupBySymbol(missing, source)
+		for i := range missing {
+			symbol := missing[i].Symbol
+			// the source is adjusted before it's resampled, so a bucket holding an ex-date isn't adjusted as a whole
+			sourceCandlesticks, err := r.adjust(&missing[i], bySymbol[symbol], adjusted)
+			if err != nil {
+				return nil, err
+			}
+
+			resampled := r.resampler.Resample(sourceCandlesticks, interval, calendars[symbol])
+			for len(resampled) > 0 && resampled[0].Timestamp.Before(from) {
+				resampled = resampled[1:]
+			}
+			result[symbol] = resampled
+		}
+	}
+
+	return result, nil
+}
+
+// StreamCandlesticks reads the range by pages following the last read timestamp, so the range is never held at once.
+// The actions are read for every page, a page is adjusted by the ones after its first candlestick.
+func (r quotesApp) StreamCandlesticks(
+	symbol string,
+	interval candlestick.Interval,
+	from, to time.Time,
+	adjusted bool,
+	pageSize int,
+	send func([]candlestick.Candlestick) error,
+) error {
+	if pageSize <= 0 {
+		return errors.New("page size must be positive")
+	}
+
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return err
+	}
+	if q.Status != quote.StatusReady {
+		return errors.New("the quote isn't ready")
+	}
+
+	page, err := r.candlestickRepo.GetCandlesticksPage(q, interval, from, to, pageSize)
+	if err != nil {
+		return err
+	}
+	if len(page) == 0 && r.resampler.CanResample(resampleSourceInterval, interval) {
+		return r.streamResampledCandlesticks(q, interval, from, to, adjusted, pageSize, send)
+	}
+
+	for len(page) > 0 {
+		next := page[len(page)-1].Timestamp.Add(time.Microsecond) // the database keeps microseconds
+		full := len(page) == pageSize
+
+		if page, err = r.adjust(q, page, adjusted); err != nil {
+			return err
+		}
+		if err := send(page); err != nil {
+			return err
+		}
+		if !full {
+			return nil
+		}
+
+		if page, err = r.candlestickRepo.GetCandlesticksPage(q, interval, next, to, pageSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamResampledCandlesticks cuts every page of the source candlesticks at the start of the day or the week
+// of its last one, that day is read again with the next page, so no bucket is ever split between pages
+func (r quotesApp) streamResampledCandlesticks(
+	q *quote.Quote,
+	interval candlestick.Interval,
+	from, to time.Time,
+	adjusted bool,
+	pageSize int,
+	send func([]candlestick.Candlestick) error,
+) error {
+	// a period never holds twice as many source candlesticks, so a full page always reaches past its first period
+	sourcePageSize := pageSize * int(interval.Duration()/resampleSourceInterval.Duration())
+	if min := 2 * int(resamplePeriod(interval)/resampleSourceInterval.Duration()); sourcePageSize < min {
+		sourcePageSize = min
+	}
+
+	c, err := q.GetCalendar()
+	if err != nil {
+		return err
+	}
+
+	start := startOfPeriod(from.In(c.Location()), interval)
+	for {
+		source, err := r.candlestickRepo.GetCandlesticksPage(q, resampleSourceInterval, start, to, sourcePageSize)
+		if err != nil {
+			return err
+		}
+
+		last := len(source) < sourcePageSize
+		if !last {
+			start = startOfPeriod(source[len(source)-1].Timestamp.In(c.Location()), interval)
+			cut := len(source)
+			for cut > 0 && !source[cut-1].Timestamp.Before(start) {
+				cut--
+			}
+			source = source[:cut]
+		}
+
+		if source, err = r.adjust(q, source, adjusted); err != nil {
+			return err
+		}
+
+		resampled := r.resampler.Resample(source, interval, c)
+		for len(resampled) > 0 && resampled[0].Timestamp.Before(from) {
+			resampled = resampled[1:]
+		}
+		if len(resampled) > 0 {
+			if err := send(resampled); err != nil {
+				return err
+			}
+		}
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// resamplePeriod is what the buckets of the interval are aligned by, a day or a week
+func resamplePeriod(interval candlestick.Interval) time.Duration {
+	if interval == candlestick.IntervalWeek {
+		return 7 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour
+}
+
+// startOfPeriod returns the local midnight of the timestamp, or the one of monday for weekly candlesticks
+func startOfPeriod(ts time.Time, interval candlestick.Interval) time.Time {
+	y, m, d := ts.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, ts.Location())
+	if interval == candlestick.IntervalWeek {
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+
+	return day
+}
+
+func (r quotesApp) GetCandlesticksByCount(
+	symbol string,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.Direction,
+	count int,
+	adjusted bool,
+) ([]candlestick.Candlestick, error) {
+	if count <= 0 || count > maxCandlesticksCount {
+		return nil, errors.New("count must be between 1 and " + strconv.Itoa(maxCandlesticksCount))
+	}
+
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.Status != quote.StatusReady {
+		return nil, errors.New("the quote isn't ready")
+	}
+
+	cs, err := r.candlestickRepo.GetCandlesticksByCount(q, interval, start, direction, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(cs) > 0 || !r.resampler.CanResample(resampleSourceInterval, interval) {
+		return r.adjust(q, cs, adjusted)
+	}
+
+	// a bucket never takes more than ratio source candlesticks, so the extra bucket
+	// makes sure a bucket cut by the limit is never returned
+	c, err := q.GetCalendar()
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := int(interval.Duration() / resampleSourceInterval.Duration())
+	sourceStart, sourceCount := start, (count+1)*ratio
+	if direction == candlestick.DirectionForward {
+		// the buckets are aligned by the start of their day, so the source is read from there,
+		// the bucket the start falls into is dropped below instead of being returned partial
+		sourceStart = startOfPeriod(start.In(c.Location()), interval)
+		sourceCount += int(resamplePeriod(interval) / resampleSourceInterval.Duration())
+	}
+
+	source, err := r.candlestickRepo.GetCandlesticksByCount(q, resampleSourceInterval, sourceStart, direction, sourceCount)
+	if err != nil {
+		return nil, err
+	}
+	full := len(source) == sourceCount
+	if source, err = r.adjust(q, source, adjusted); err != nil {
+		return nil, err
+	}
+
+	resampled := r.resampler.Resample(source, interval, c)
+	for direction == candlestick.DirectionForward && len(resampled) > 0 && resampled[0].Timestamp.Before(start) {
+		resampled = resampled[1:]
+	}
+	// reading backward by the limit likely starts in the middle of a bucket, it's dropped unless the history ends there
+	if direction == candlestick.DirectionBackward && full && len(resampled) > 0 {
+		resampled = resampled[1:]
+	}
+	if len(resampled) > count && direction == candlestick.DirectionBackward {
+		resampled = resampled[len(resampled)-count:]
+	}
+	if len(resampled) > count && direction == candlestick.DirectionForward {
+		resampled = resampled[:count]
+	}
+
+	return resampled, nil
+}
+
+// adjust sets AdjClose of the quote's candlesticks, the other prices are adjusted too if asked
+func (r quotesApp) adjust(q *quote.Quote, cs []candlestick.Candlestick, adjusted bool) ([]candlestick.Candlestick, error) {
+	if len(cs) == 0 {
+		return cs, nil
+	}
+
+	actions, err := r.actionRepo.GetActions(q, cs[0].Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return action.Adjust(cs, actions, adjusted), nil
+}
+
+func (r quotesApp) getReadyQuotes(symbols []string) ([]quote.Quote, error) {
+	ready, err := r.quoteRepo.GetQuotes(quote.StatusReady)
+	if err != nil {
+		return nil, err
+	}
+
+	bySymbol := make(map[string]quote.Quote, len(ready))
+	for i := range ready {
+		bySymbol[ready[i].Symbol] = ready[i]
+	}
+
+	quotes := make([]quote.Quote, 0, len(symbols))
+	for i := range symbols {
+		q, ok := bySymbol[symbols[i]]
+		if !ok {
+			return nil, errors.New("the quote " + symbols[i] + " isn't ready")
+		}
+		quotes = append(quotes, q)
+	}
+
+	return quotes, nil
+}
+
+// groupBySymbol keeps the order of candlesticks, every quote gets an entry even if it has none
+func groupBySymbol(quotes []quote.Quote, cs []candlestick.Candlestick) map[string][]candlestick.Candlestick {
+	symbols := make(map[int64]string, len(quotes))
+	result := make(map[string][]candlestick.Candlestick, len(quotes))
+	for i := range quotes {
+		symbols[quotes[i].ID] = quotes[i].Symbol
+		result[quotes[i].Symbol] = []candlestick.Candlestick{}
+	}
+
+	for i := range cs {
+		symbol := symbols[cs[i].QuoteID]
+		result[symbol] = append(result[symbol], cs[i])
+	}
+
+	return result
+}
+
+// getResampledCandlesticks adjusts the source before resampling it, so a bucket holding an ex-date isn't adjusted as a whole
+func (r quotesApp) getResampledCandlesticks(
+	q *quote.Quote,
+	interval candlestick.Interval,
+	from, to time.Time,
+	adjusted bool,
+) ([]candlestick.Candlestick, error) {
+	c, err := q.GetCalendar()
+	if err != nil {
+		return nil, err
+	}
+
+	// the source starts with the period of from so the first bucket isn't cut, it is dropped below if it starts before from
+	source, err := r.candlestickRepo.GetCandlesticks(q, resampleSourceInterval, startOfPeriod(from.In(c.Location()), interval), to)
+	if err != nil {
+		return nil, err
+	}
+	if source, err = r.adjust(q, source, adjusted); err != nil {
+		return nil, err
+	}
+
+	resampled := r.resampler.Resample(source, interval, c)
+	for len(resampled) > 0 && resampled[0].Timestamp.Before(from) {
+		resampled = resampled[1:]
+	}
+
+	return resampled, nil
+}
+
+func (r quotesApp) GetCandlestickIssues(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Issue, error) {
+	q, err := r.quoteRepo.GetQuote(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if q.ID == 0 {
+		return nil, errors.New("the quote " + symbol + " doesn't exist")
+	}
+
+	return r.issueRepo.GetIssues(q, interval, from, to)
 }
 
 func (r quotesApp) HealthCheck() bool {
@@ -23,18 +23,60 @@ type quotesLoggingMiddleware struct {
 	next   QuotesApp
 }
 
-func (mw quotesLoggingMiddleware) GetQuotes() (quotes []quote.Quote, err error) {
+func (mw quotesLoggingMiddleware) GetQuotes(filter quote.Filter) (quotes []quote.Quote, err error) {
 	defer func() {
-		_ = mw.logger.Log("method", "GetQuotes", "error", err)
+		_ = mw.logger.Log("method", "GetQuotes", "exchange", filter.Exchange, "currency", filter.Currency, "asset_class", filter.AssetClass, "error", err)
 	}()
-	return mw.next.GetQuotes()
+	return mw.next.GetQuotes(filter)
 }
 
-func (mw quotesLoggingMiddleware) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time) (candlesticks []candlestick.Candlestick, err error) {
+func (mw quotesLoggingMiddleware) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time, adjusted bool) (candlesticks []candlestick.Candlestick, err error) {
 	defer func() {
-		_ = mw.logger.Log("method", "GetCandlesticks", "symbol", symbol, "interval", interval, "from", from, "to", to, "error", err)
+		_ = mw.logger.Log("method", "GetCandlesticks", "symbol", symbol, "interval", interval, "from", from, "to", to, "adjusted", adjusted, "error", err)
 	}()
-	return mw.next.GetCandlesticks(symbol, interval, from, to)
+	return mw.next.GetCandlesticks(symbol, interval, from, to, adjusted)
+}
+
+func (mw quotesLoggingMiddleware) GetCandlesticksBatch(symbols []string, interval candlestick.Interval, from, to time.Time, adjusted bool) (candlesticks map[string][]candlestick.Candlestick, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "GetCandlesticksBatch", "symbols", len(symbols), "interval", interval, "from", from, "to", to, "adjusted", adjusted, "error", err)
+	}()
+	return mw.next.GetCandlesticksBatch(symbols, interval, from, to, adjusted)
+}
+
+func (mw quotesLoggingMiddleware) StreamCandlesticks(
+	symbol string,
+	interval candlestick.Interval,
+	from, to time.Time,
+	adjusted bool,
+	pageSize int,
+	send func([]candlestick.Candlestick) error,
+) (err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "StreamCandlesticks", "symbol", symbol, "interval", interval, "from", from, "to", to, "adjusted", adjusted, "page_size", pageSize, "error", err)
+	}()
+	return mw.next.StreamCandlesticks(symbol, interval, from, to, adjusted, pageSize, send)
+}
+
+func (mw quotesLoggingMiddleware) GetCandlesticksByCount(
+	symbol string,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.Direction,
+	count int,
+	adjusted bool,
+) (candlesticks []candlestick.Candlestick, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "GetCandlesticksByCount", "symbol", symbol, "interval", interval, "start", start, "direction", direction, "count", count, "adjusted", adjusted, "error", err)
+	}()
+	return mw.next.GetCandlesticksByCount(symbol, interval, start, direction, count, adjusted)
+}
+
+func (mw quotesLoggingMiddleware) GetCandlestickIssues(symbol string, interval candlestick.Interval, from, to time.Time) (issues []candlestick.Issue, err error) {
+	defer func() {
+		_ = mw.logger.Log("method", "GetCandlestickIssues", "symbol", symbol, "interval", interval, "from", from, "to", to, "error", err)
+	}()
+	return mw.next.GetCandlestickIssues(symbol, interval, from, to)
 }
 
 func (mw quotesLoggingMiddleware) HealthCheck() bool {
@@ -55,18 +97,57 @@ type quotesInstrumentingMiddleware struct {
 	next    QuotesApp
 }
 
-func (mw quotesInstrumentingMiddleware) GetQuotes() ([]quote.Quote, error) {
-	v, err := mw.next.GetQuotes()
+func (mw quotesInstrumentingMiddleware) GetQuotes(filter quote.Filter) ([]quote.Quote, error) {
+	v, err := mw.next.GetQuotes(filter)
 	mw.counter.Add(float64(len(v)))
 	return v, err
 }
 
-func (mw quotesInstrumentingMiddleware) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error) {
-	v, err := mw.next.GetCandlesticks(symbol, interval, from, to)
+func (mw quotesInstrumentingMiddleware) GetCandlesticks(symbol string, interval candlestick.Interval, from, to time.Time, adjusted bool) ([]candlestick.Candlestick, error) {
+	v, err := mw.next.GetCandlesticks(symbol, interval, from, to, adjusted)
+	mw.counter.Add(float64(len(v)))
+	return v, err
+}
+
+func (mw quotesInstrumentingMiddleware) GetCandlesticksBatch(symbols []string, interval candlestick.Interval, from, to time.Time, adjusted bool) (map[string][]candlestick.Candlestick, error) {
+	v, err := mw.next.GetCandlesticksBatch(symbols, interval, from, to, adjusted)
+	for symbol := range v {
+		mw.counter.Add(float64(len(v[symbol])))
+	}
+	return v, err
+}
+
+func (mw quotesInstrumentingMiddleware) StreamCandlesticks(
+	symbol string,
+	interval candlestick.Interval,
+	from, to time.Time,
+	adjusted bool,
+	pageSize int,
+	send func([]candlestick.Candlestick) error,
+) error {
+	return mw.next.StreamCandlesticks(symbol, interval, from, to, adjusted, pageSize, func(cs []candlestick.Candlestick) error {
+		mw.counter.Add(float64(len(cs)))
+		return send(cs)
+	})
+}
+
+func (mw quotesInstrumentingMiddleware) GetCandlesticksByCount(
+	symbol string,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.Direction,
+	count int,
+	adjusted bool,
+) ([]candlestick.Candlestick, error) {
+	v, err := mw.next.GetCandlesticksByCount(symbol, interval, start, direction, count, adjusted)
 	mw.counter.Add(float64(len(v)))
 	return v, err
 }
 
+func (mw quotesInstrumentingMiddleware) GetCandlestickIssues(symbol string, interval candlestick.Interval, from, to time.Time) ([]candlestick.Issue, error) {
+	return mw.next.GetCandlestickIssues(symbol, interval, from, to)
+}
+
 func (mw quotesInstrumentingMiddleware) HealthCheck() bool {
 	return mw.next.HealthCheck()
 }
@@ -0,0 +1,388 @@
+package app
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type stubQuoteRepository struct {
+	quote.Repository
+	quote quote.Quote
+}
+
+func (r stubQuoteRepository) GetQuote(string) (*quote.Quote, error) {
+	q := r.quote
+	return &q, nil
+}
+
+type stubActionRepository struct {
+	action.Repository
+	actions []action.Action
+}
+
+func (r stubActionRepository) GetActions(*quote.Quote, time.Time) ([]action.Action, error) {
+	return r.actions, nil
+}
+
+// memoryCandlestickRepository serves the candlesticks of one quote and counts the pages read
+type memoryCandlestickRepository struct {
+	candlestick.Repository
+	candlesticks []candlestick.Candlestick
+	pages        int
+}
+
+func (r *memoryCandlestickRepository) GetCandlesticksPage(
+	_ *quote.Quote,
+	interval candlestick.Interval,
+	from, to time.Time,
+	limit int,
+) ([]candlestick.Candlestick, error) {
+	r.pages++
+
+	var page []candlestick.Candlestick
+	for _, c := range r.candlesticks {
+		if c.Interval == interval && !c.Timestamp.Before(from) && !c.Timestamp.After(to) && len(page) < limit {
+			page = append(page, c)
+		}
+	}
+
+	return page, nil
+}
+
+func (r *memoryCandlestickRepository) GetCandlesticks(
+	_ *quote.Quote,
+	interval candlestick.Interval,
+	from, to time.Time,
+) ([]candlestick.Candlestick, error) {
+	var result []candlestick.Candlestick
+	for _, c := range r.candlesticks {
+		if c.Interval == interval && !c.Timestamp.Before(from) && !c.Timestamp.After(to) {
+			result = append(result, c)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *memoryCandlestickRepository) GetCandlesticksByCount(
+	_ *quote.Quote,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.Direction,
+	count int,
+) ([]candlestick.Candlestick, error) {
+	var result []candlestick.Candlestick
+	for _, c := range r.candlesticks {
+		if c.Interval != interval {
+			continue
+		}
+		if direction == candlestick.DirectionForward && !c.Timestamp.Before(start) ||
+			direction == candlestick.DirectionBackward && !c.Timestamp.After(start) {
+			result = append(result, c)
+		}
+	}
+
+	if len(result) > count && direction == candlestick.DirectionForward {
+		result = result[:count]
+	}
+	if len(result) > count && direction == candlestick.DirectionBackward {
+		result = result[len(result)-count:]
+	}
+
+	return result, nil
+}
+
+func newTestQuotesApp(repo candlestick.Repository) QuotesApp {
+	return newTestQuotesAppFor(quote.Quote{ID: 1, Symbol: "AAPL", Status: quote.StatusReady}, repo)
+}
+
+func newTestQuotesAppFor(q quote.Quote, repo candlestick.Repository, actions ...action.Action) QuotesApp {
+	return NewQuotesApp(
+		log.NewNopLogger(),
+		discard.NewCounter(),
+		stubQuoteRepository{quote: q},
+		repo,
+		stubActionRepository{actions: actions},
+		nil,
+		candlestick.NewSessionResampler(),
+	)
+}
+
+// tradingHours returns the hourly candlesticks of the regular sessions of the days, the close is the hour index
+func tradingHours(location *time.Location, days ...time.Time) []candlestick.Candlestick {
+	var cs []candlestick.Candlestick
+	for _, day := range days {
+		for h := 0; h < 7; h++ {
+			price := decimal.NewFromInt(int64(len(cs) + 1))
+			cs = append(cs, candlestick.Candlestick{
+				Open:      price,
+				Low:       price,
+				High:      price,
+				Close:     price,
+				AdjClose:  price,
+				Volume:    1,
+				Timestamp: time.Date(day.Year(), day.Month(), day.Day(), 9, 30, 0, 0, location).Add(time.Duration(h) * time.Hour),
+				Interval:  candlestick.IntervalHour,
+				QuoteID:   1,
+			})
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Timestamp.Before(cs[j].Timestamp) })
+
+	return cs
+}
+
+func TestQuotesApp_StreamCandlesticks_Pages(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	repo := &memoryCandlestickRepository{candlesticks: tradingHours(
+		location,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 2, 0, 0, 0, 0, location),
+	)}
+
+	var sent []candlestick.Candlestick
+	var chunks int
+	err := newTestQuotesApp(repo).StreamCandlesticks(
+		"AAPL",
+		candlestick.IntervalHour,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 3, 0, 0, 0, 0, location),
+		false,
+		5,
+		func(cs []candlestick.Candlestick) error {
+			chunks++
+			sent = append(sent, cs...)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sent) != 14 || chunks != 3 || repo.pages != 3 {
+		t.Fatal(len(sent), chunks, repo.pages)
+	}
+	for i := 1; i < len(sent); i++ {
+		if !sent[i].Timestamp.After(sent[i-1].Timestamp) {
+			t.Error(i, sent[i].Timestamp)
+		}
+	}
+}
+
+func TestQuotesApp_StreamCandlesticks_Resampled(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	var days []time.Time
+	for d := 1; d <= 12; d++ {
+		if day := time.Date(2021, 3, d, 0, 0, 0, 0, location); day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			days = append(days, day)
+		}
+	}
+	repo := &memoryCandlestickRepository{candlesticks: tradingHours(location, days...)}
+
+	var sent []candlestick.Candlestick
+	err := newTestQuotesApp(repo).StreamCandlesticks(
+		"AAPL",
+		candlestick.IntervalDay,
+		time.Date(2021, 3, 1, 12, 0, 0, 0, location),
+		time.Date(2021, 3, 13, 0, 0, 0, 0, location),
+		false,
+		1,
+		func(cs []candlestick.Candlestick) error {
+			sent = append(sent, cs...)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the day the range starts in is dropped as it starts before from, the others are never split between pages
+	if len(sent) != len(days)-1 || repo.pages != 3 {
+		t.Fatal(len(sent), repo.pages)
+	}
+	for i := range sent {
+		if sent[i].Volume != 7 || !sent[i].Timestamp.Equal(time.Date(2021, 3, days[i+1].Day(), 9, 30, 0, 0, location)) {
+			t.Error(i, sent[i].Timestamp, sent[i].Volume)
+		}
+	}
+}
+
+func TestQuotesApp_GetCandlesticks_ResampledFromUnaligned(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	repo := &memoryCandlestickRepository{candlesticks: tradingHours(
+		location,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 2, 0, 0, 0, 0, location),
+	)}
+
+	// the bucket from falls into is dropped, the rest are counted from the session open whatever from is
+	cs, err := newTestQuotesApp(repo).GetCandlesticks(
+		"AAPL",
+		candlestick.Interval4Hours,
+		time.Date(2021, 3, 1, 15, 0, 0, 0, location),
+		time.Date(2021, 3, 3, 0, 0, 0, 0, location),
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 {
+		t.Fatal(cs)
+	}
+	if !cs[0].Timestamp.Equal(time.Date(2021, 3, 2, 9, 30, 0, 0, location)) || cs[0].Volume != 4 {
+		t.Error(cs[0].Timestamp, cs[0].Volume)
+	}
+	if !cs[1].Timestamp.Equal(time.Date(2021, 3, 2, 13, 30, 0, 0, location)) || cs[1].Volume != 3 {
+		t.Error(cs[1].Timestamp, cs[1].Volume)
+	}
+}
+
+func TestQuotesApp_GetCandlesticksByCount_ResampledForward(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	repo := &memoryCandlestickRepository{candlesticks: tradingHours(
+		location,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 2, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 3, 0, 0, 0, 0, location),
+	)}
+
+	// the start falls into the first bucket of the session, which is skipped instead of being cut
+	cs, err := newTestQuotesApp(repo).GetCandlesticksByCount(
+		"AAPL",
+		candlestick.Interval4Hours,
+		time.Date(2021, 3, 1, 11, 30, 0, 0, location),
+		candlestick.DirectionForward,
+		2,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 {
+		t.Fatal(cs)
+	}
+	if !cs[0].Timestamp.Equal(time.Date(2021, 3, 1, 13, 30, 0, 0, location)) || cs[0].Volume != 3 {
+		t.Error(cs[0].Timestamp, cs[0].Volume)
+	}
+	if !cs[1].Timestamp.Equal(time.Date(2021, 3, 2, 9, 30, 0, 0, location)) || cs[1].Volume != 4 {
+		t.Error(cs[1].Timestamp, cs[1].Volume)
+	}
+}
+
+func TestQuotesApp_GetCandlesticksByCount_ResampledBackward(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	repo := &memoryCandlestickRepository{candlesticks: tradingHours(
+		location,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 2, 0, 0, 0, 0, location),
+		time.Date(2021, 3, 3, 0, 0, 0, 0, location),
+	)}
+
+	cs, err := newTestQuotesApp(repo).GetCandlesticksByCount(
+		"AAPL",
+		candlestick.IntervalDay,
+		time.Date(2021, 3, 3, 0, 0, 0, 0, location),
+		candlestick.DirectionBackward,
+		2,
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 || cs[0].Timestamp.Day() != 1 || cs[1].Timestamp.Day() != 2 || cs[0].Volume != 7 || cs[1].Volume != 7 {
+		t.Fatal(cs)
+	}
+}
+
+func TestQuotesApp_GetCandlesticks_ResampledByQuoteCalendar(t *testing.T) {
+	var hours []candlestick.Candlestick
+	for h := 0; h < 48; h++ {
+		hours = append(hours, candlestick.Candlestick{
+			Close:     decimal.NewFromInt(int64(h)),
+			Volume:    1,
+			Timestamp: time.Date(2021, 3, 1, h, 0, 0, 0, time.UTC),
+			Interval:  candlestick.IntervalHour,
+			QuoteID:   1,
+		})
+	}
+	q := quote.Quote{ID: 1, Symbol: "BTCUSD", Status: quote.StatusReady, Timezone: "UTC", TradingHours: quote.TradingHoursAlways}
+
+	// a 24/7 quote is cut by UTC days whatever the timezone of the exchanges is
+	cs, err := newTestQuotesAppFor(q, &memoryCandlestickRepository{candlesticks: hours}).GetCandlesticks(
+		"BTCUSD",
+		candlestick.IntervalDay,
+		time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC),
+		false,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cs) != 2 {
+		t.Fatal(cs)
+	}
+	for i := range cs {
+		if !cs[i].Timestamp.Equal(time.Date(2021, 3, 1+i, 0, 0, 0, 0, time.UTC)) || cs[i].Volume != 24 {
+			t.Error(i, cs[i].Timestamp, cs[i].Volume)
+		}
+	}
+}
+
+func TestQuotesApp_GetCandlesticks_ResampledAcrossSplit(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	var days []time.Time
+	for d := 1; d <= 5; d++ {
+		days = append(days, time.Date(2021, 3, d, 0, 0, 0, 0, location))
+	}
+	hours := tradingHours(location, days...)
+	for i := range hours {
+		// 400 till the 4 for 1 split on wednesday, 100 after it
+		price := decimal.NewFromInt(100)
+		if hours[i].Timestamp.Day() < 3 {
+			price = decimal.NewFromInt(400)
+		}
+		hours[i].Open, hours[i].Low, hours[i].High, hours[i].Close, hours[i].AdjClose = price, price, price, price, price
+	}
+	split := action.NewSplit(1, time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC), decimal.NewFromInt(4))
+	q := quote.Quote{ID: 1, Symbol: "AAPL", Status: quote.StatusReady}
+
+	for _, adjusted := range []bool{false, true} {
+		weeks, err := newTestQuotesAppFor(q, &memoryCandlestickRepository{candlesticks: hours}, split).GetCandlesticks(
+			"AAPL",
+			candlestick.IntervalWeek,
+			days[0],
+			days[4].AddDate(0, 0, 1),
+			adjusted,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the week is adjusted by its source candlesticks, only the ones before the ex-date get the factor
+		if len(weeks) != 1 {
+			t.Fatal(adjusted, weeks)
+		}
+		if !weeks[0].Close.Equals(decimal.NewFromInt(100)) || !weeks[0].AdjClose.Equals(decimal.NewFromInt(100)) {
+			t.Error(adjusted, weeks[0].Close, weeks[0].AdjClose)
+		}
+		if open := map[bool]int64{false: 400, true: 100}[adjusted]; !weeks[0].Open.Equals(decimal.NewFromInt(open)) {
+			t.Error(adjusted, weeks[0].Open)
+		}
+		if volume := map[bool]int{false: 35, true: 77}[adjusted]; weeks[0].Volume != volume {
+			t.Error(adjusted, weeks[0].Volume)
+		}
+	}
+}
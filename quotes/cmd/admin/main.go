@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/websmee/ms/pkg/cmd"
+	"google.golang.org/grpc"
+
+	"github.com/websmee/example_of_my_code/quotes/api/proto"
+)
+
+const usage = `commands:
+  create [-provider P] [-provider_symbol S] [-exchange E] [-currency C]
+         [-asset_class A] [-timezone TZ] [-trading_hours 09:30-16:00|24/7] SYMBOL NAME
+  rename SYMBOL NEW_SYMBOL [NEW_NAME]
+  update [-exchange E] [-currency C] [-asset_class A] [-timezone TZ]
+         [-trading_hours 09:30-16:00|24/7] SYMBOL
+  suspend SYMBOL
+  resume SYMBOL
+  delete SYMBOL
+  load SYMBOL
+  jobs [-type latest|actions|backfill|load] [-status S,...] [-limit N] [SYMBOL]
+  backfill SYMBOL FROM [TO]`
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	fs := flag.NewFlagSet("admin", flag.ExitOnError)
+	var (
+		quotesAddr = fs.String("quotes.addr", "127.0.0.1:8086", "admin address of the quotes service")
+		timeout    = fs.Duration("timeout", 30*time.Second, "request timeout")
+	)
+	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags] <command> [args]\n\n"+usage)
+	_ = fs.Parse(os.Args[1:])
+
+	args := fs.Args()
+	if len(args) < 1 || (len(args) < 2 && args[0] != "jobs") {
+		fs.Usage()
+		return errors.New("command and symbol are required")
+	}
+
+	conn, err := grpc.Dial(*quotesAddr, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrap(err, "dial failed")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	client := proto.NewQuotesAdminClient(conn)
+	switch args[0] {
+	case "jobs":
+		return listJobs(ctx, client, args[1:])
+	case "backfill":
+		return backfill(ctx, client, args[1:])
+	}
+
+	reply, err := call(ctx, client, args[0], args[1:])
+	if err != nil {
+		return err
+	}
+	if reply.Err != "" {
+		return errors.New(reply.Err)
+	}
+
+	q := reply.Quote
+	fmt.Printf(
+		"%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		q.Id, q.Symbol, q.Name, q.Status, q.Provider, q.ProviderSymbol,
+		q.Exchange, q.Currency, q.AssetClass, q.Timezone, q.TradingHours,
+	)
+
+	return nil
+}
+
+// parseCreateRequest leaves the omitted fields empty, so the service applies its defaults
+func parseCreateRequest(args []string) (*proto.CreateQuoteRequest, error) {
+	req := &proto.CreateQuoteRequest{}
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	fs.StringVar(&req.Provider, "provider", "", "market data provider")
+	fs.StringVar(&req.ProviderSymbol, "provider_symbol", "", "symbol the provider knows the quote by")
+	fs.StringVar(&req.Exchange, "exchange", "", "exchange, e.g. NYSE")
+	fs.StringVar(&req.Currency, "currency", "", "currency the quote is priced in, e.g. USD")
+	fs.StringVar(&req.AssetClass, "asset_class", "", "stock, etf, future, forex or crypto")
+	fs.StringVar(&req.Timezone, "timezone", "", "exchange timezone, e.g. America/New_York")
+	fs.StringVar(&req.TradingHours, "trading_hours", "", "local trading hours like 09:30-16:00, or 24/7")
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		return nil, errors.New("create requires SYMBOL and NAME")
+	}
+	req.Symbol = fs.Arg(0)
+	req.Name = fs.Arg(1)
+
+	return req, nil
+}
+
+// parseUpdateRequest leaves the omitted fields empty, so the service keeps their values
+func parseUpdateRequest(args []string) (*proto.UpdateQuoteMetadataRequest, error) {
+	req := &proto.UpdateQuoteMetadataRequest{}
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	fs.StringVar(&req.Exchange, "exchange", "", "exchange, e.g. NYSE")
+	fs.StringVar(&req.Currency, "currency", "", "currency the quote is priced in, e.g. USD")
+	fs.StringVar(&req.AssetClass, "asset_class", "", "stock, etf, future, forex or crypto")
+	fs.StringVar(&req.Timezone, "timezone", "", "exchange timezone, e.g. America/New_York")
+	fs.StringVar(&req.TradingHours, "trading_hours", "", "local trading hours like 09:30-16:00, or 24/7")
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		return nil, errors.New("update requires SYMBOL")
+	}
+	req.Symbol = fs.Arg(0)
+
+	return req, nil
+}
+
+func call(ctx context.Context, client proto.QuotesAdminClient, command string, args []string) (*proto.QuoteReply, error) {
+	symbolRequest := &proto.QuoteSymbolRequest{Symbol: args[0]}
+	switch command {
+	case "create":
+		req, err := parseCreateRequest(args)
+		if err != nil {
+			return nil, err
+		}
+		return client.CreateQuote(ctx, req)
+	case "rename":
+		if len(args) < 2 {
+			return nil, errors.New("rename requires SYMBOL and NEW_SYMBOL")
+		}
+		req := &proto.RenameQuoteRequest{Symbol: args[0], NewSymbol: args[1]}
+		if len(args) > 2 {
+			req.NewName = args[2]
+		}
+		return client.RenameQuote(ctx, req)
+	case "update":
+		req, err := parseUpdateRequest(args)
+		if err != nil {
+			return nil, err
+		}
+		return client.UpdateQuoteMetadata(ctx, req)
+	case "suspend":
+		return client.SuspendQuote(ctx, symbolRequest)
+	case "resume":
+		return client.ResumeQuote(ctx, symbolRequest)
+	case "delete":
+		return client.DeleteQuote(ctx, symbolRequest)
+	case "load":
+		return client.LoadQuote(ctx, symbolRequest)
+	default:
+		return nil, errors.Errorf("unknown command %q", command)
+	}
+}
+
+func listJobs(ctx context.Context, client proto.QuotesAdminClient, args []string) error {
+	req := &proto.ListJobsRequest{}
+	var statuses string
+	var limit int
+	fs := flag.NewFlagSet("jobs", flag.ExitOnError)
+	fs.StringVar(&req.Type, "type", "", "latest, actions, backfill or load")
+	fs.StringVar(&statuses, "status", "", "comma-separated statuses: pending,running,failed,succeeded")
+	fs.IntVar(&limit, "limit", 0, "number of the latest jobs to list")
+	_ = fs.Parse(args)
+
+	if statuses != "" {
+		req.Statuses = strings.Split(statuses, ",")
+	}
+	req.Limit = int32(limit)
+	req.Symbol = fs.Arg(0)
+
+	reply, err := client.ListJobs(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Err != "" {
+		return errors.New(reply.Err)
+	}
+
+	for _, j := range reply.Jobs {
+		printJob(j)
+	}
+
+	return nil
+}
+
+func backfill(ctx context.Context, client proto.QuotesAdminClient, args []string) error {
+	if len(args) < 2 {
+		return errors.New("backfill requires SYMBOL and FROM")
+	}
+	req := &proto.BackfillQuoteRequest{Symbol: args[0], From: args[1]}
+	if len(args) > 2 {
+		req.To = args[2]
+	}
+
+	reply, err := client.BackfillQuote(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Err != "" {
+		return errors.New(reply.Err)
+	}
+
+	printJob(reply.Job)
+
+	return nil
+}
+
+func printJob(j *proto.Job) {
+	fmt.Printf(
+		"%d\t%s\t%s\t%s\t%d/%d\t%s\t%s\t%s\t%s\n",
+		j.Id, j.Type, j.Symbol, j.Status, j.Attempts, j.MaxAttempts,
+		formatUnix(j.From), formatUnix(j.To), formatUnix(j.RunAt), j.Error,
+	)
+}
+
+func formatUnix(t int64) string {
+	if t == 0 {
+		return "-"
+	}
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
@@ -15,8 +15,8 @@ import (
 	"github.com/websmee/ms/pkg/errors"
 
 	"github.com/websmee/example_of_my_code/quotes/cmd/dependencies"
-	"github.com/websmee/example_of_my_code/quotes/infrastructure"
-	"github.com/websmee/example_of_my_code/quotes/infrastructure/tiingo"
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
 
 	"github.com/websmee/ms/pkg/discovery"
 	"github.com/websmee/ms/pkg/discovery/health"
@@ -38,6 +38,7 @@ import (
 	"github.com/websmee/example_of_my_code/quotes/app"
 	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
 	"github.com/websmee/example_of_my_code/quotes/infrastructure/persistence"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/tiingo"
 )
 
 func main() {
@@ -53,6 +54,8 @@ func run() error {
 		debugPort         = fs.String("debug.port", "8080", "Debug and metrics listen port")
 		grpcAddr          = fs.String("grpc.addr", "0.0.0.0", "gRPC listen address")
 		grpcPort          = fs.String("grpc.port", "8082", "gRPC listen port")
+		adminAddr         = fs.String("admin.addr", "127.0.0.1", "admin gRPC listen address, keep it private, the admin API has no auth")
+		adminPort         = fs.String("admin.port", "8086", "admin gRPC listen port")
 		consulAddr        = fs.String("consul.addr", "127.0.0.1", "consul address")
 		consulPort        = fs.String("consul.port", "8500", "consul port")
 		consulServiceName = fs.String("consul.service_name", "quotes", "consul service name")
@@ -61,6 +64,16 @@ func run() error {
 		zipkinURL         = fs.String("zipkin-url", "", "Enable Zipkin tracing via HTTP reporter URL e.g. http://localhost:9411/api/v2/spans")
 		zipkinBridge      = fs.Bool("zipkin-ot-bridge", false, "Use Zipkin OpenTracing bridge instead of native implementation")
 		dbMigrationsPath  = fs.String("db-migrations-path", "infrastructure/persistence/migrations/", "Where to find migrations")
+		loaderIntervals   = fs.String("loader.intervals", "1h", "comma-separated candlestick intervals to load: 1m,5m,15m,1h,4h,1d,1w")
+		loaderStart       = fs.String("loader.start", "2018-01-01T00:00:00Z", "RFC3339 time the candlestick history starts at")
+		scheduleEvery     = fs.Duration("loader.schedule_every", time.Hour, "how often the latest candlesticks are loaded")
+		scheduleOffset    = fs.Duration("loader.schedule_offset", time.Minute, "offset of the loads from the start of the period, so the last candlestick is closed")
+		actionsEvery      = fs.Duration("loader.actions_every", 24*time.Hour, "how often the latest corporate actions are loaded")
+		actionsOffset     = fs.Duration("loader.actions_offset", 2*time.Hour, "offset of the corporate action loads from the start of the period")
+		jobMaxAttempts    = fs.Int("loader.max_attempts", 5, "attempts of an ingestion job before it fails")
+		jobRetryBackoff   = fs.Duration("loader.retry_backoff", time.Minute, "delay of the first retry of a failed ingestion job, it doubles with every next one")
+		jobPollInterval   = fs.Duration("loader.poll_interval", 10*time.Second, "how often the worker looks for due ingestion jobs")
+		jobLease          = fs.Duration("loader.job_lease", 5*time.Minute, "how long a running ingestion job without a heartbeat stays taken, it is retried after that")
 	)
 	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags]")
 	_ = fs.Parse(os.Args[1:])
@@ -110,8 +123,11 @@ func run() error {
 	// CONFIG
 
 	var (
-		dbConfig     *config.DB
-		tiingoConfig *config.Tiingo
+		dbConfig        *config.DB
+		tiingoConfig    *config.Tiingo
+		providersConfig *config.Providers
+		intervals       []candlestick.Interval
+		historyStart    time.Time
 	)
 	{
 		cfg, err := config.NewConsulKVConfig(*consulAddr+":"+*consulPort, logger)
@@ -131,6 +147,30 @@ func run() error {
 			_ = logger.Log("config", "tiingo", "error", err, "stack", errors.GetStackTrace(err))
 			return err
 		}
+
+		providersConfig, err = cfg.GetProviders()
+		if err != nil {
+			_ = logger.Log("config", "providers", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+
+		intervals, err = candlestick.ParseIntervals(*loaderIntervals)
+		if err != nil {
+			_ = logger.Log("config", "intervals", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+
+		historyStart, err = time.Parse(time.RFC3339, *loaderStart)
+		if err != nil {
+			_ = logger.Log("config", "start", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+
+		if *scheduleEvery <= 0 || *actionsEvery <= 0 || *jobMaxAttempts <= 0 {
+			err = fmt.Errorf("loader.schedule_every, loader.actions_every and loader.max_attempts must be positive")
+			_ = logger.Log("config", "schedule", "error", err)
+			return err
+		}
 	}
 
 	// DB
@@ -157,9 +197,14 @@ func run() error {
 		extLogger       = logger
 		quoteRepo       = persistence.NewQuoteRepository(db)
 		candlestickRepo = persistence.NewCandlestickRepository(db)
-		quotes          = app.NewQuotesApp(extLogger, count, quoteRepo, candlestickRepo)
+		actionRepo      = persistence.NewActionRepository(db)
+		issueRepo       = persistence.NewCandlestickIssueRepository(db)
+		candlestickBus  = app.NewCandlestickBus()
+		jobRepo         = persistence.NewJobRepository(db)
+		quotes          = app.NewQuotesApp(extLogger, count, quoteRepo, candlestickRepo, actionRepo, issueRepo, candlestick.NewSessionResampler())
 		endpoints       = api.NewQuotes(quotes, extLogger, duration, tracer, zipkinTracer)
 		grpcServer      = api.NewGRPCServer(endpoints, tracer, zipkinTracer, logger)
+		grpcServerV2    = api.NewGRPCServerV2(endpoints, candlestickBus, tracer, zipkinTracer, logger)
 
 		healthCheckEndpoint = health.NewCheckEndpoint(func(service string) health.CheckStatus {
 			if quotes.HealthCheck() {
@@ -171,12 +216,40 @@ func run() error {
 
 		serviceRegistrar = discovery.NewConsulRegistrar(*consulAddr+":"+*consulPort, logger)
 
+		tiingoClient = tiingo.NewClient(tiingoConfig)
+
 		candlestickLoader = app.NewCandlestickLoader(
 			extLogger,
-			infrastructure.NewTiingoCandlestickLoader(tiingo.NewClient(tiingoConfig)),
+			dependencies.GetCandlestickLoader(tiingoClient, providersConfig),
+			dependencies.GetActionLoader(tiingoClient, providersConfig),
 			candlestickRepo,
+			actionRepo,
+			issueRepo,
+			quoteRepo,
+			dependencies.GetValidator(),
+			dependencies.GetGapFinders(),
+			candlestickBus,
+			intervals,
+			historyStart,
+		)
+		ingestion = app.NewIngestionApp(
+			extLogger,
+			candlestickLoader,
+			jobRepo,
 			quoteRepo,
+			*jobMaxAttempts,
+			*jobRetryBackoff,
+			*jobLease,
 		)
+		quoteManager = app.NewQuoteManagerApp(
+			extLogger,
+			quoteRepo,
+			ingestion,
+			dependencies.GetProviderNames(providersConfig),
+			providersConfig.Default,
+		)
+		adminEndpoints  = api.NewQuotesAdmin(quoteManager, ingestion, extLogger, duration, tracer, zipkinTracer)
+		adminGRPCServer = api.NewAdminGRPCServer(adminEndpoints, tracer, zipkinTracer, logger)
 	)
 
 	var g group.Group
@@ -218,6 +291,7 @@ func run() error {
 			_ = logger.Log("transport", "gRPC", "addr", addr)
 			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
 			proto.RegisterQuotesServer(baseServer, grpcServer)
+			proto.RegisterQuotesV2Server(baseServer, grpcServerV2)
 			healthProto.RegisterHealthServer(baseServer, grpcHealthServer)
 			return baseServer.Serve(grpcListener)
 		}, func(error) {
@@ -226,21 +300,96 @@ func run() error {
 		})
 	}
 	{
-		// LOAD LATEST CANDLESTICKS
+		// ADMIN GRPC
+
+		// the admin API isn't registered in consul, it is reached only by the admin CLI
+		addr := *adminAddr + ":" + *adminPort
+		adminListener, err := net.Listen("tcp", addr)
+		if err != nil {
+			_ = logger.Log("transport", "admin/gRPC", "during", "Listen", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+		g.Add(func() error {
+			_ = logger.Log("transport", "admin/gRPC", "addr", addr)
+			baseServer := grpc.NewServer(grpc.UnaryInterceptor(kitgrpc.Interceptor))
+			proto.RegisterQuotesAdminServer(baseServer, adminGRPCServer)
+			return baseServer.Serve(adminListener)
+		}, func(error) {
+			adminListener.Close()
+		})
+	}
+	{
+		// SCHEDULE LATEST CANDLESTICKS
 
-		ticker := time.NewTicker(time.Minute)
+		schedule := job.Schedule{Every: *scheduleEvery, Offset: *scheduleOffset}
+		quit := make(chan struct{})
 		g.Add(func() error {
-			for range ticker.C {
-				if time.Now().Minute() == 1 { // every first minute of every hour
-					if err := candlestickLoader.LoadLatest(); err != nil {
-						_ = logger.Log("background task", "load latest candlesticks", "error", err, "stack", errors.GetStackTrace(err))
-					}
+			for {
+				timer := time.NewTimer(time.Until(schedule.Next(time.Now())))
+				select {
+				case <-timer.C:
+					_, _ = ingestion.ScheduleLatest() // the app logs the result
+				case <-quit:
+					timer.Stop()
+					return nil
 				}
 			}
+		}, func(error) {
+			close(quit)
+		})
+	}
+	{
+		// SCHEDULE LATEST CORPORATE ACTIONS
 
-			return nil
+		schedule := job.Schedule{Every: *actionsEvery, Offset: *actionsOffset}
+		quit := make(chan struct{})
+		g.Add(func() error {
+			for {
+				timer := time.NewTimer(time.Until(schedule.Next(time.Now())))
+				select {
+				case <-timer.C:
+					_, _ = ingestion.ScheduleActions() // the app logs the result
+				case <-quit:
+					timer.Stop()
+					return nil
+				}
+			}
+		}, func(error) {
+			close(quit)
+		})
+	}
+	{
+		// RUN INGESTION JOBS
+
+		ticker := time.NewTicker(*jobPollInterval)
+		quit := make(chan struct{})
+		g.Add(func() error {
+			for {
+				// the jobs of a crashed worker, of this instance or another one, are retried once their lease is over
+				_, _ = ingestion.ResetStaleJobs() // the app logs the result
+
+				// run the due jobs one by one until there are none left
+				for {
+					j, err := ingestion.RunDueJob()
+					if err != nil || j == nil {
+						break
+					}
+					select {
+					case <-quit:
+						return nil
+					default:
+					}
+				}
+
+				select {
+				case <-ticker.C:
+				case <-quit:
+					return nil
+				}
+			}
 		}, func(error) {
 			ticker.Stop()
+			close(quit)
 		})
 	}
 	{
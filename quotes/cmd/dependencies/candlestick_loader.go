@@ -0,0 +1,57 @@
+package dependencies
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/tiingo"
+)
+
+// GetCandlestickLoader shares the tiingo client with GetActionLoader, so both keep to the same request limit.
+func GetCandlestickLoader(tiingoClient tiingo.Client, providersConfig *config.Providers) candlestick.Loader {
+	loaders := make(map[string]candlestick.Loader, len(providersConfig.HTTP)+2)
+	for name, cfg := range providersConfig.HTTP {
+		loaders[name] = infrastructure.NewHTTPCandlestickLoader(cfg)
+	}
+	loaders[infrastructure.ProviderTiingo] = infrastructure.NewTiingoCandlestickLoader(tiingoClient)
+	loaders[infrastructure.ProviderCSV] = infrastructure.NewCSVCandlestickLoader(providersConfig.CSV.Path)
+
+	return infrastructure.NewCandlestickLoaderRegistry(loaders, providersConfig.Default)
+}
+
+// GetActionLoader loads corporate actions of tiingo quotes only, the other providers serve no actions.
+func GetActionLoader(tiingoClient tiingo.Client, providersConfig *config.Providers) action.Loader {
+	return infrastructure.NewActionLoaderRegistry(map[string]action.Loader{
+		infrastructure.ProviderTiingo: infrastructure.NewTiingoActionLoader(tiingoClient),
+	}, providersConfig.Default)
+}
+
+// GetValidator quarantines candlesticks moving 50 times in one bar, no real market does that without a split
+func GetValidator() candlestick.Validator {
+	return candlestick.NewValidator(decimal.NewFromInt(50))
+}
+
+// GetGapFinders expects every quote to trade by the calendar of its market, US exchanges keep to the NYSE holidays
+func GetGapFinders() candlestick.GapFinderFactory {
+	return func(q quote.Quote) (candlestick.GapFinder, error) {
+		c, err := q.GetCalendar()
+		if err != nil {
+			return nil, err
+		}
+
+		return candlestick.NewCalendarGapFinder(c), nil
+	}
+}
+
+func GetProviderNames(providersConfig *config.Providers) []string {
+	names := []string{infrastructure.ProviderTiingo, infrastructure.ProviderCSV}
+	for name := range providersConfig.HTTP {
+		names = append(names, name)
+	}
+
+	return names
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/go-pg/pg/v9"
 	"github.com/websmee/ms/pkg/cmd"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/websmee/example_of_my_code/quotes/app"
 	"github.com/websmee/example_of_my_code/quotes/cmd/dependencies"
-	"github.com/websmee/example_of_my_code/quotes/infrastructure"
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
 	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
 	"github.com/websmee/example_of_my_code/quotes/infrastructure/persistence"
 	"github.com/websmee/example_of_my_code/quotes/infrastructure/tiingo"
@@ -30,6 +31,8 @@ func run() error {
 		consulAddr       = fs.String("consul.addr", "127.0.0.1", "consul address")
 		consulPort       = fs.String("consul.port", "8500", "consul port")
 		dbMigrationsPath = fs.String("db-migrations-path", "infrastructure/persistence/migrations/", "Where to find migrations")
+		loaderIntervals  = fs.String("loader.intervals", "1h", "comma-separated candlestick intervals to load: 1m,5m,15m,1h,4h,1d,1w")
+		loaderStart      = fs.String("loader.start", "2018-01-01T00:00:00Z", "RFC3339 time the candlestick history starts at")
 	)
 	fs.Usage = cmd.UsageFor(fs, os.Args[0]+" [flags]")
 	_ = fs.Parse(os.Args[1:])
@@ -41,8 +44,11 @@ func run() error {
 	// CONFIG
 
 	var (
-		dbConfig     *config.DB
-		tiingoConfig *config.Tiingo
+		dbConfig        *config.DB
+		tiingoConfig    *config.Tiingo
+		providersConfig *config.Providers
+		intervals       []candlestick.Interval
+		historyStart    time.Time
 	)
 	{
 		cfg, err := config.NewConsulKVConfig(*consulAddr+":"+*consulPort, logger)
@@ -62,6 +68,24 @@ func run() error {
 			_ = logger.Log("config", "tiingo", "error", err, "stack", errors.GetStackTrace(err))
 			return err
 		}
+
+		providersConfig, err = cfg.GetProviders()
+		if err != nil {
+			_ = logger.Log("config", "providers", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+
+		intervals, err = candlestick.ParseIntervals(*loaderIntervals)
+		if err != nil {
+			_ = logger.Log("config", "intervals", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
+
+		historyStart, err = time.Parse(time.RFC3339, *loaderStart)
+		if err != nil {
+			_ = logger.Log("config", "start", "error", err, "stack", errors.GetStackTrace(err))
+			return err
+		}
 	}
 
 	// DB
@@ -84,20 +108,41 @@ func run() error {
 
 	// INIT
 
+	tiingoClient := tiingo.NewClient(tiingoConfig)
 	loader := app.NewCandlestickLoader(
 		logger,
-		infrastructure.NewTiingoCandlestickLoader(tiingo.NewClient(tiingoConfig)),
+		dependencies.GetCandlestickLoader(tiingoClient, providersConfig),
+		dependencies.GetActionLoader(tiingoClient, providersConfig),
 		persistence.NewCandlestickRepository(db),
+		persistence.NewActionRepository(db),
+		persistence.NewCandlestickIssueRepository(db),
 		persistence.NewQuoteRepository(db),
+		dependencies.GetValidator(),
+		dependencies.GetGapFinders(),
+		app.NewCandlestickBus(),
+		intervals,
+		historyStart,
 	)
 
 	// RUN
 
-	if err := loader.LoadCandlesticks(); err != nil {
+	coverage, err := loader.LoadCandlesticks()
+	if err != nil {
 		_ = logger.Log("run", "loaderApp", "error", err, "stack", errors.GetStackTrace(err))
 		return err
 	}
 
+	for _, c := range coverage {
+		_ = logger.Log(
+			"coverage", c.Symbol,
+			"interval", c.Interval,
+			"expected", c.Expected,
+			"stored", c.Stored,
+			"gaps", c.Gaps,
+			"percent", fmt.Sprintf("%.2f", c.Percent()),
+		)
+	}
+
 	_ = logger.Log("run", "exit")
 
 	return nil
@@ -0,0 +1,63 @@
+package action
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type Type string
+
+const (
+	TypeSplit    Type = "split"
+	TypeDividend Type = "dividend"
+)
+
+// Action is a corporate action changing the prices of a quote since the ex-date.
+// Factor is the multiplier of the prices before the ex-date making them comparable to the later ones.
+type Action struct {
+	tableName struct{} `pg:"corporate_actions"`
+
+	QuoteID int64
+	Type    Type
+	ExDate  time.Time
+	// Ratio is the number of new shares per old one of a split
+	Ratio decimal.Decimal `pg:",use_zero"`
+	// Amount is the cash per share of a dividend
+	Amount decimal.Decimal `pg:",use_zero"`
+	Factor decimal.Decimal `pg:",use_zero"`
+}
+
+func NewSplit(quoteID int64, exDate time.Time, ratio decimal.Decimal) Action {
+	return Action{
+		QuoteID: quoteID,
+		Type:    TypeSplit,
+		ExDate:  exDate,
+		Ratio:   ratio,
+		Factor:  decimal.NewFromInt(1).Div(ratio),
+	}
+}
+
+// NewDividend needs the close of the last session before the ex-date to find the factor.
+func NewDividend(quoteID int64, exDate time.Time, amount, prevClose decimal.Decimal) Action {
+	return Action{
+		QuoteID: quoteID,
+		Type:    TypeDividend,
+		ExDate:  exDate,
+		Amount:  amount,
+		Factor:  decimal.NewFromInt(1).Sub(amount.Div(prevClose)),
+	}
+}
+
+type Repository interface {
+	// SaveActions replaces the stored actions having the same quote, type and ex-date.
+	SaveActions(actions []Action) error
+	// GetActions returns the actions of the quote with the ex-date after from ordered by the ex-date.
+	GetActions(quote *quote.Quote, from time.Time) ([]Action, error)
+}
+
+type Loader interface {
+	LoadActions(quote quote.Quote, start, end time.Time) ([]Action, error)
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+)
+
+// adjustedPrecision keeps the factors with repeating decimals, like the one of a 3 for 1 split, from showing up in prices
+const adjustedPrecision = 6
+
+// Adjust sets AdjClose of the candlesticks to the close adjusted by all the later actions.
+// With ohlc set the rest of the prices and the volume are adjusted too, so the series has no jumps at the ex-dates.
+// Both slices are expected to be ordered by time, the candlesticks are changed in place.
+func Adjust(candlesticks []candlestick.Candlestick, actions []Action, ohlc bool) []candlestick.Candlestick {
+	factor := decimal.NewFromInt(1)
+	volumeFactor := decimal.NewFromInt(1)
+	next := len(actions) - 1
+	for i := len(candlesticks) - 1; i >= 0; i-- {
+		c := &candlesticks[i]
+		for ; next >= 0 && c.Timestamp.Before(actions[next].ExDate); next-- {
+			factor = factor.Mul(actions[next].Factor)
+			if actions[next].Type == TypeSplit {
+				volumeFactor = volumeFactor.Mul(actions[next].Ratio)
+			}
+		}
+
+		c.AdjClose = c.Close.Mul(factor).Round(adjustedPrecision)
+		if ohlc {
+			c.Open = c.Open.Mul(factor).Round(adjustedPrecision)
+			c.Low = c.Low.Mul(factor).Round(adjustedPrecision)
+			c.High = c.High.Mul(factor).Round(adjustedPrecision)
+			c.Close = c.AdjClose
+			c.Volume = int(decimal.NewFromInt(int64(c.Volume)).Mul(volumeFactor).IntPart())
+		}
+	}
+
+	return candlesticks
+}
@@ -0,0 +1,60 @@
+package action
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+)
+
+func TestAdjust(t *testing.T) {
+	day := func(m time.Month, d int, price int64) candlestick.Candlestick {
+		return candlestick.Candlestick{
+			Open:      decimal.NewFromInt(price),
+			Low:       decimal.NewFromInt(price),
+			High:      decimal.NewFromInt(price),
+			Close:     decimal.NewFromInt(price),
+			Volume:    100,
+			Timestamp: time.Date(2020, m, d, 0, 0, 0, 0, time.UTC),
+			Interval:  candlestick.IntervalDay,
+		}
+	}
+	series := func() []candlestick.Candlestick {
+		return []candlestick.Candlestick{day(8, 27, 2000), day(8, 28, 2000), day(8, 31, 500), day(9, 1, 490)}
+	}
+	// the 4 for 1 split of AAPL and a made up dividend after it
+	actions := []Action{
+		NewSplit(1, time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC), decimal.NewFromInt(4)),
+		NewDividend(1, time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC), decimal.NewFromInt(5), decimal.NewFromInt(500)),
+	}
+
+	{
+		// RAW
+		cs := Adjust(series(), actions, false)
+		if !cs[0].Close.Equals(decimal.NewFromInt(2000)) || cs[0].Volume != 100 {
+			t.Error(cs[0])
+		}
+		if !cs[0].AdjClose.Equals(decimal.NewFromInt(495)) {
+			t.Error(cs[0].AdjClose)
+		}
+		if !cs[3].AdjClose.Equals(decimal.NewFromInt(490)) {
+			t.Error(cs[3].AdjClose)
+		}
+	}
+
+	{
+		// ADJUSTED
+		cs := Adjust(series(), actions, true)
+		expected := []int64{495, 495, 495, 490}
+		for i := range cs {
+			if !cs[i].Close.Equals(decimal.NewFromInt(expected[i])) || !cs[i].Open.Equals(cs[i].Close) {
+				t.Error(i, cs[i])
+			}
+		}
+		if cs[0].Volume != 400 || cs[2].Volume != 100 {
+			t.Error(cs[0].Volume, cs[2].Volume)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package candlestick
+
+import (
+	"time"
+
+	"github.com/websmee/example_of_my_code/calendar"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+const gapMergeDistance = 4 * 24 * time.Hour
+
+// Gap is a range of missing candlesticks, both ends are inclusive.
+type Gap struct {
+	From, To time.Time
+}
+
+type GapFinder interface {
+	// FindGaps expects timestamps ordered ascending and returns the ranges of trading sessions having no candlesticks.
+	FindGaps(timestamps []time.Time, interval Interval, from, to time.Time) []Gap
+	// CountExpected returns how many candlesticks the trading sessions between from and to consist of.
+	CountExpected(interval Interval, from, to time.Time) int
+}
+
+// GapFinderFactory builds the gap finder of a quote, every quote trades by the calendar of its market.
+type GapFinderFactory func(q quote.Quote) (GapFinder, error)
+
+type calendarGapFinder struct {
+	calendar calendar.Calendar
+}
+
+// NewCalendarGapFinder expects trading in the sessions of the calendar, holidays and early closes included.
+func NewCalendarGapFinder(calendar calendar.Calendar) GapFinder {
+	return &calendarGapFinder{
+		calendar: calendar,
+	}
+}
+
+func (r calendarGapFinder) FindGaps(timestamps []time.Time, interval Interval, from, to time.Time) []Gap {
+	if interval == IntervalWeek {
+		return mergeGaps(r.findWeekGaps(timestamps, from, to))
+	}
+
+	var gaps []Gap
+	i := 0
+	for _, session := range r.getSessions(from, to) {
+		if interval.Duration() >= 24*time.Hour {
+			// daily candlesticks are stamped with the session date
+			for i < len(timestamps) && timestamps[i].Before(session.From) && !isSameDate(timestamps[i], session.From) {
+				i++
+			}
+			if i == len(timestamps) || !isSameDate(timestamps[i], session.From) {
+				gaps = append(gaps, session)
+			}
+			continue
+		}
+
+		for i < len(timestamps) && timestamps[i].Before(session.From) {
+			i++
+		}
+
+		cursor := session.From
+		for ; i < len(timestamps) && timestamps[i].Before(session.To); i++ {
+			if timestamps[i].Sub(cursor) >= interval.Duration() {
+				gaps = append(gaps, Gap{From: cursor, To: timestamps[i].Add(-interval.Duration())})
+			}
+			cursor = timestamps[i].Add(interval.Duration())
+		}
+		if session.To.Sub(cursor) >= interval.Duration() {
+			gaps = append(gaps, Gap{From: cursor, To: session.To.Add(-interval.Duration())})
+		}
+	}
+
+	return mergeGaps(gaps)
+}
+
+// findWeekGaps returns the trading weeks having no candlestick, a weekly one is stamped with a date of its week
+func (r calendarGapFinder) findWeekGaps(timestamps []time.Time, from, to time.Time) []Gap {
+	var weeks []Gap
+	for _, session := range r.getSessions(from, to) {
+		if len(weeks) > 0 && mondayOf(session.From).Equal(mondayOf(weeks[len(weeks)-1].From)) {
+			weeks[len(weeks)-1].To = session.To
+			continue
+		}
+		weeks = append(weeks, session)
+	}
+
+	var gaps []Gap
+	i := 0
+	for _, week := range weeks {
+		for i < len(timestamps) && mondayOf(timestamps[i]).Before(mondayOf(week.From.In(timestamps[i].Location()))) {
+			i++
+		}
+		if i == len(timestamps) || !mondayOf(timestamps[i]).Equal(mondayOf(week.From.In(timestamps[i].Location()))) {
+			gaps = append(gaps, week)
+		}
+	}
+
+	return gaps
+}
+
+func (r calendarGapFinder) CountExpected(interval Interval, from, to time.Time) int {
+	sessions := r.getSessions(from, to)
+	switch {
+	case interval == IntervalWeek:
+		weeks := make(map[int64]bool)
+		for i := range sessions {
+			weeks[startOfDay(sessions[i].From).AddDate(0, 0, -(int(sessions[i].From.Weekday())+6)%7).Unix()] = true
+		}
+		return len(weeks)
+	case interval.Duration() >= 24*time.Hour:
+		return len(sessions)
+	}
+
+	count := 0
+	for i := range sessions {
+		d := sessions[i].To.Sub(sessions[i].From)
+		count += int((d + interval.Duration() - 1) / interval.Duration())
+	}
+
+	return count
+}
+
+// getSessions returns the sessions between from and to, the first and the last ones are cut to fit
+func (r calendarGapFinder) getSessions(from, to time.Time) []Gap {
+	var sessions []Gap
+	for _, s := range r.calendar.GetSessions(from, to) {
+		session := Gap{From: s.Open, To: s.Close}
+		if session.From.Before(from) {
+			session.From = from
+		}
+		if session.To.After(to) {
+			session.To = to
+		}
+		if session.From.Before(session.To) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
+
+// mergeGaps joins gaps lying close to each other, so a few missing days in a row are backfilled at once
+func mergeGaps(gaps []Gap) []Gap {
+	var merged []Gap
+	for i := range gaps {
+		if len(merged) > 0 && gaps[i].From.Sub(merged[len(merged)-1].To) <= gapMergeDistance {
+			merged[len(merged)-1].To = gaps[i].To
+			continue
+		}
+		merged = append(merged, gaps[i])
+	}
+
+	return merged
+}
+
+// mondayOf returns the date of the monday of the week the local date of the timestamp falls into
+func mondayOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+func isSameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.In(a.Location()).Date()
+
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,100 @@
+package candlestick
+
+import (
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+func TestCalendarGapFinder_FindGaps(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	finder := NewCalendarGapFinder(calendar.NewWeekdayCalendar(location, 9*time.Hour+30*time.Minute, 16*time.Hour))
+
+	// monday to wednesday, tuesday is missing completely and wednesday lacks its last hours
+	from := time.Date(2021, 3, 1, 0, 0, 0, 0, location)
+	to := time.Date(2021, 3, 4, 0, 0, 0, 0, location)
+
+	var timestamps []time.Time
+	for h := 9; h <= 15; h++ {
+		timestamps = append(timestamps, time.Date(2021, 3, 1, h, 30, 0, 0, location))
+	}
+	for h := 9; h <= 12; h++ {
+		timestamps = append(timestamps, time.Date(2021, 3, 3, h, 30, 0, 0, location))
+	}
+
+	gaps := finder.FindGaps(timestamps, IntervalHour, from, to)
+	if len(gaps) != 1 {
+		t.Fatal(gaps)
+	}
+	if !gaps[0].From.Equal(time.Date(2021, 3, 2, 9, 30, 0, 0, location)) {
+		t.Error(gaps[0].From)
+	}
+	if !gaps[0].To.Equal(time.Date(2021, 3, 3, 15, 0, 0, 0, location)) {
+		t.Error(gaps[0].To)
+	}
+
+	if n := finder.CountExpected(IntervalHour, from, to); n != 21 {
+		t.Error(n)
+	}
+	if n := finder.CountExpected(IntervalDay, from, to); n != 3 {
+		t.Error(n)
+	}
+}
+
+func TestCalendarGapFinder_Holidays(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	finder := NewCalendarGapFinder(calendar.NewNYSECalendar())
+
+	// thanksgiving week, the exchange is closed on thursday and closes at 13:00 on friday
+	from := time.Date(2021, 11, 24, 0, 0, 0, 0, location)
+	to := time.Date(2021, 11, 27, 0, 0, 0, 0, location)
+
+	timestamps := []time.Time{
+		time.Date(2021, 11, 24, 0, 0, 0, 0, location),
+		time.Date(2021, 11, 26, 0, 0, 0, 0, location),
+	}
+	if gaps := finder.FindGaps(timestamps, IntervalDay, from, to); len(gaps) != 0 {
+		t.Error(gaps)
+	}
+
+	timestamps = nil
+	for h := 9; h <= 12; h++ {
+		timestamps = append(timestamps, time.Date(2021, 11, 26, h, 30, 0, 0, location))
+	}
+	if gaps := finder.FindGaps(timestamps, IntervalHour, from.AddDate(0, 0, 1), to); len(gaps) != 0 {
+		t.Error(gaps)
+	}
+
+	if n := finder.CountExpected(IntervalHour, from.AddDate(0, 0, 1), to); n != 4 {
+		t.Error(n)
+	}
+}
+
+func TestCalendarGapFinder_Weeks(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	finder := NewCalendarGapFinder(calendar.NewWeekdayCalendar(location, 9*time.Hour+30*time.Minute, 16*time.Hour))
+
+	// four weeks of march, the second and the fourth ones are missing
+	from := time.Date(2021, 3, 1, 0, 0, 0, 0, location)
+	to := time.Date(2021, 3, 27, 0, 0, 0, 0, location)
+	timestamps := []time.Time{
+		time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	gaps := finder.FindGaps(timestamps, IntervalWeek, from, to)
+	if len(gaps) != 2 {
+		t.Fatal(gaps)
+	}
+	if !gaps[0].From.Equal(time.Date(2021, 3, 8, 9, 30, 0, 0, location)) || !gaps[0].To.Equal(time.Date(2021, 3, 12, 16, 0, 0, 0, location)) {
+		t.Error(gaps[0])
+	}
+	if !gaps[1].From.Equal(time.Date(2021, 3, 22, 9, 30, 0, 0, location)) || !gaps[1].To.Equal(time.Date(2021, 3, 26, 16, 0, 0, 0, location)) {
+		t.Error(gaps[1])
+	}
+
+	if n := finder.CountExpected(IntervalWeek, from, to); n != 4 {
+		t.Error(n)
+	}
+}
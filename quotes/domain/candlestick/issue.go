@@ -0,0 +1,50 @@
+package candlestick
+
+import (
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type IssueType string
+
+const (
+	IssueTypeDuplicate    IssueType = "duplicate"
+	IssueTypeZeroPrice    IssueType = "zero_price"
+	IssueTypeZeroVolume   IssueType = "zero_volume"
+	IssueTypeHighBelowLow IssueType = "high_below_low"
+	IssueTypeOutOfRange   IssueType = "out_of_range"
+	IssueTypePriceSpike   IssueType = "price_spike"
+)
+
+type Resolution string
+
+const (
+	// ResolutionFlagged candlesticks are saved as they are
+	ResolutionFlagged Resolution = "flagged"
+	// ResolutionRepaired candlesticks are saved fixed
+	ResolutionRepaired Resolution = "repaired"
+	// ResolutionQuarantined candlesticks aren't saved, the issue keeps their prices
+	ResolutionQuarantined Resolution = "quarantined"
+)
+
+// Issue is a data-quality problem the validator found in a loaded candlestick.
+type Issue struct {
+	tableName struct{} `pg:"candlestick_issues"`
+
+	QuoteID    int64
+	Interval   Interval
+	Timestamp  time.Time
+	Type       IssueType
+	Resolution Resolution
+	Details    string
+	CreatedAt  time.Time
+}
+
+type IssueRepository interface {
+	// SaveIssues replaces the stored issues of the same type found in the same candlesticks.
+	SaveIssues(issues []Issue) error
+	GetIssues(quote *quote.Quote, interval Interval, from, to time.Time) ([]Issue, error)
+	// GetQuarantinedTimestamps returns the ordered timestamps of the quarantined candlesticks, they aren't stored.
+	GetQuarantinedTimestamps(quote *quote.Quote, interval Interval, from, to time.Time) ([]time.Time, error)
+}
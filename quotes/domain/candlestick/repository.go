@@ -8,6 +8,16 @@ import (
 
 type Repository interface {
 	SaveCandlestick(candlestick *Candlestick) error
+	// SaveCandlesticks saves all the candlesticks or none of them.
+	SaveCandlesticks(candlesticks []Candlestick) error
 	GetCandlesticks(quote *quote.Quote, interval Interval, from, to time.Time) ([]Candlestick, error)
+	// GetCandlesticksPage returns up to limit candlesticks between from and to ordered by timestamp, both ends are inclusive.
+	GetCandlesticksPage(quote *quote.Quote, interval Interval, from, to time.Time, limit int) ([]Candlestick, error)
+	// GetCandlesticksBatch returns candlesticks of all the quotes ordered by quote and timestamp.
+	GetCandlesticksBatch(quotes []quote.Quote, interval Interval, from, to time.Time) ([]Candlestick, error)
+	// GetCandlesticksByCount returns up to count candlesticks closest to the start ordered by timestamp.
+	GetCandlesticksByCount(quote *quote.Quote, interval Interval, start time.Time, direction Direction, count int) ([]Candlestick, error)
+	// GetLastCandlestickTimestamp returns zero time if there are no candlesticks yet.
 	GetLastCandlestickTimestamp(quote *quote.Quote, interval Interval) (time.Time, error)
+	GetCandlestickTimestamps(quote *quote.Quote, interval Interval, from, to time.Time) ([]time.Time, error)
 }
@@ -0,0 +1,104 @@
+package candlestick
+
+import (
+	"time"
+
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+// Resampler builds candlesticks of a coarser interval from finer ones.
+type Resampler interface {
+	CanResample(source, target Interval) bool
+	Resample(candlesticks []Candlestick, interval Interval, c calendar.Calendar) []Candlestick
+}
+
+type sessionResampler struct{}
+
+// NewSessionResampler returns a resampler that never merges candlesticks of different trading sessions.
+// Intraday buckets are counted from the open of the calendar session, days and weeks are cut in the calendar's
+// location, so the buckets don't depend on where the candlesticks start.
+func NewSessionResampler() Resampler {
+	return &sessionResampler{}
+}
+
+func (r sessionResampler) CanResample(source, target Interval) bool {
+	return source.Duration() > 0 &&
+		target.Duration() > source.Duration() &&
+		target.Duration()%source.Duration() == 0
+}
+
+// Resample expects candlesticks ordered by timestamp ascending.
+// Every resulting candlestick gets the timestamp of its first source candlestick.
+func (r sessionResampler) Resample(candlesticks []Candlestick, interval Interval, c calendar.Calendar) []Candlestick {
+	if len(candlesticks) == 0 {
+		return nil
+	}
+
+	location := c.Location()
+	sessions := c.GetSessions(candlesticks[0].Timestamp, candlesticks[len(candlesticks)-1].Timestamp.Add(time.Nanosecond))
+
+	var result []Candlestick
+	var current bucket
+	for i := range candlesticks {
+		ts := candlesticks[i].Timestamp.In(location)
+		for len(sessions) > 0 && !sessions[0].Close.After(ts) {
+			sessions = sessions[1:]
+		}
+
+		// the candlesticks out of the sessions, the extended hours ones, are counted from the local midnight
+		open := startOfDay(ts)
+		if len(sessions) > 0 && !ts.Before(sessions[0].Open) {
+			open = sessions[0].Open
+		}
+
+		b := r.getBucket(ts, open, interval)
+		if i == 0 || b != current {
+			current = b
+			result = append(result, candlesticks[i])
+			result[len(result)-1].Interval = interval
+			continue
+		}
+
+		merge(&result[len(result)-1], candlesticks[i])
+	}
+
+	return result
+}
+
+type bucket struct {
+	start int64
+	index int64
+}
+
+func (r sessionResampler) getBucket(ts, open time.Time, interval Interval) bucket {
+	switch interval {
+	case IntervalDay:
+		return bucket{start: startOfDay(ts).Unix()}
+	case IntervalWeek:
+		// weeks start on monday
+		day := startOfDay(ts)
+		return bucket{start: day.AddDate(0, 0, -(int(day.Weekday())+6)%7).Unix()}
+	default:
+		return bucket{
+			start: open.Unix(),
+			index: int64(ts.Sub(open) / interval.Duration()),
+		}
+	}
+}
+
+func startOfDay(ts time.Time) time.Time {
+	y, m, d := ts.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, ts.Location())
+}
+
+func merge(to *Candlestick, c Candlestick) {
+	if c.High.GreaterThan(to.High) {
+		to.High = c.High
+	}
+	if c.Low.LessThan(to.Low) {
+		to.Low = c.Low
+	}
+	to.Close = c.Close
+	to.AdjClose = c.AdjClose
+	to.Volume += c.Volume
+}
@@ -0,0 +1,93 @@
+package candlestick
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
+func TestSessionResampler_Resample(t *testing.T) {
+	location, _ := time.LoadLocation("America/New_York")
+	hour := func(day, h, price int) Candlestick {
+		return Candlestick{
+			Open:      decimal.NewFromInt(int64(price)),
+			Low:       decimal.NewFromInt(int64(price - 1)),
+			High:      decimal.NewFromInt(int64(price + 1)),
+			Close:     decimal.NewFromInt(int64(price)),
+			Volume:    10,
+			Timestamp: time.Date(2021, 3, day, h, 30, 0, 0, location),
+			Interval:  IntervalHour,
+		}
+	}
+
+	var candlesticks []Candlestick
+	for h := 9; h <= 15; h++ { // monday
+		candlesticks = append(candlesticks, hour(1, h, h))
+	}
+	for h := 9; h <= 15; h++ { // tuesday
+		candlesticks = append(candlesticks, hour(2, h, 100+h))
+	}
+
+	resampler := NewSessionResampler()
+	nyse := calendar.NewNYSECalendar()
+
+	{
+		// DAY
+		days := resampler.Resample(candlesticks, IntervalDay, nyse)
+		if len(days) != 2 {
+			t.Fatal(len(days))
+		}
+		if !days[0].Open.Equals(decimal.NewFromInt(9)) || !days[0].Close.Equals(decimal.NewFromInt(15)) {
+			t.Error(days[0].Open, days[0].Close)
+		}
+		if !days[0].Low.Equals(decimal.NewFromInt(8)) || !days[0].High.Equals(decimal.NewFromInt(16)) {
+			t.Error(days[0].Low, days[0].High)
+		}
+		if days[0].Volume != 70 || days[0].Interval != IntervalDay {
+			t.Error(days[0].Volume, days[0].Interval)
+		}
+	}
+
+	{
+		// 4 HOURS, the overnight gap starts a new bucket
+		bars := resampler.Resample(candlesticks, Interval4Hours, nyse)
+		if len(bars) != 4 {
+			t.Fatal(len(bars))
+		}
+		if bars[1].Volume != 30 || !bars[2].Open.Equals(decimal.NewFromInt(109)) {
+			t.Error(bars[1].Volume, bars[2].Open)
+		}
+	}
+
+	{
+		// 4 HOURS, the buckets are counted from the session open even if the candlesticks start later
+		bars := resampler.Resample(candlesticks[1:], Interval4Hours, nyse)
+		if len(bars) != 4 {
+			t.Fatal(len(bars))
+		}
+		if bars[0].Volume != 30 || !bars[1].Timestamp.Equal(time.Date(2021, 3, 1, 13, 30, 0, 0, location)) || bars[1].Volume != 30 {
+			t.Error(bars[0].Volume, bars[1].Timestamp, bars[1].Volume)
+		}
+	}
+
+	{
+		// WEEK
+		weeks := resampler.Resample(candlesticks, IntervalWeek, nyse)
+		if len(weeks) != 1 || weeks[0].Volume != 140 {
+			t.Error(len(weeks))
+		}
+	}
+}
+
+func TestSessionResampler_CanResample(t *testing.T) {
+	resampler := NewSessionResampler()
+	if !resampler.CanResample(IntervalHour, IntervalDay) {
+		t.Error(IntervalHour, IntervalDay)
+	}
+	if resampler.CanResample(IntervalDay, IntervalHour) {
+		t.Error(IntervalDay, IntervalHour)
+	}
+}
@@ -0,0 +1,125 @@
+package candlestick
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type Validator interface {
+	// Validate orders the candlesticks by timestamp, repairs what it can and leaves out the quarantined ones.
+	// The previous candlestick is the last stored one before them, it is nil if there are none.
+	Validate(q quote.Quote, candlesticks []Candlestick, previous *Candlestick) ([]Candlestick, []Issue)
+}
+
+type validator struct {
+	spikeRatio decimal.Decimal
+}
+
+// NewValidator quarantines candlesticks closing spikeRatio times higher or lower than the previous close.
+func NewValidator(spikeRatio decimal.Decimal) Validator {
+	return &validator{
+		spikeRatio: spikeRatio,
+	}
+}
+
+func (r validator) Validate(q quote.Quote, candlesticks []Candlestick, previous *Candlestick) ([]Candlestick, []Issue) {
+	cs := make([]Candlestick, len(candlesticks))
+	copy(cs, candlesticks)
+	sort.SliceStable(cs, func(i, j int) bool {
+		return cs[i].Timestamp.Before(cs[j].Timestamp)
+	})
+
+	var issues []Issue
+	now := time.Now().UTC()
+	addIssue := func(c Candlestick, t IssueType, resolution Resolution, details string) {
+		issues = append(issues, Issue{
+			QuoteID:    c.QuoteID,
+			Interval:   c.Interval,
+			Timestamp:  c.Timestamp,
+			Type:       t,
+			Resolution: resolution,
+			Details:    details,
+			CreatedAt:  now,
+		})
+	}
+
+	// a bar is a spike if it jumps away from both the last seen bar and the last accepted one, so a bar
+	// returning from a spike is accepted and a real level shift is quarantined only at its first bar
+	var result []Candlestick
+	accepted := previous
+	for i := range cs {
+		c := cs[i]
+
+		// a provider correcting a candlestick within one response sends the right one last,
+		// one issue is added per timestamp as the issues are keyed by it
+		if i+1 < len(cs) && cs[i+1].Timestamp.Equal(c.Timestamp) {
+			if i == 0 || !cs[i-1].Timestamp.Equal(c.Timestamp) {
+				replaced := 1
+				for j := i + 1; j+1 < len(cs) && cs[j+1].Timestamp.Equal(c.Timestamp); j++ {
+					replaced++
+				}
+				addIssue(c, IssueTypeDuplicate, ResolutionRepaired, fmt.Sprintf("%d replaced by the last one, the first %s", replaced, describe(c)))
+			}
+			continue
+		}
+
+		if !c.Open.IsPositive() || !c.Low.IsPositive() || !c.High.IsPositive() || !c.Close.IsPositive() {
+			addIssue(c, IssueTypeZeroPrice, ResolutionQuarantined, describe(c))
+			continue
+		}
+
+		// there is no volume of the whole market for forex, providers send zero
+		if c.Volume <= 0 {
+			if q.AssetClass != quote.AssetClassForex {
+				addIssue(c, IssueTypeZeroVolume, ResolutionQuarantined, describe(c))
+				continue
+			}
+			addIssue(c, IssueTypeZeroVolume, ResolutionFlagged, describe(c))
+		}
+
+		if c.High.LessThan(c.Low) {
+			addIssue(c, IssueTypeHighBelowLow, ResolutionRepaired, "swapped, "+describe(c))
+			c.High, c.Low = c.Low, c.High
+		}
+
+		if outOfRange(c.Open, c) || outOfRange(c.Close, c) {
+			addIssue(c, IssueTypeOutOfRange, ResolutionRepaired, "range extended, "+describe(c))
+			c.Low = decimal.Min(c.Low, c.Open, c.Close)
+			c.High = decimal.Max(c.High, c.Open, c.Close)
+		}
+
+		seen := previous
+		previous = &c
+		if seen != nil && accepted != nil && r.isSpike(seen.Close, c.Close) && r.isSpike(accepted.Close, c.Close) {
+			addIssue(c, IssueTypePriceSpike, ResolutionQuarantined, "previous close "+seen.Close.String()+", "+describe(c))
+			continue
+		}
+
+		result = append(result, c)
+		accepted = &c
+	}
+
+	return result, issues
+}
+
+func (r validator) isSpike(previousClose, close decimal.Decimal) bool {
+	if !previousClose.IsPositive() {
+		return false
+	}
+
+	ratio := close.Div(previousClose)
+	return ratio.GreaterThan(r.spikeRatio) || ratio.Mul(r.spikeRatio).LessThan(decimal.NewFromInt(1))
+}
+
+func outOfRange(price decimal.Decimal, c Candlestick) bool {
+	return price.LessThan(c.Low) || price.GreaterThan(c.High)
+}
+
+func describe(c Candlestick) string {
+	return fmt.Sprintf("open %s, low %s, high %s, close %s, volume %d", c.Open, c.Low, c.High, c.Close, c.Volume)
+}
@@ -0,0 +1,101 @@
+package candlestick
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	bar := func(h int, open, low, high, close int64, volume int) Candlestick {
+		return Candlestick{
+			Open:      decimal.NewFromInt(open),
+			Low:       decimal.NewFromInt(low),
+			High:      decimal.NewFromInt(high),
+			Close:     decimal.NewFromInt(close),
+			Volume:    volume,
+			Timestamp: time.Date(2021, 3, 1, h, 30, 0, 0, time.UTC),
+			Interval:  IntervalHour,
+			QuoteID:   1,
+		}
+	}
+
+	previous := bar(13, 100, 99, 101, 100, 10)
+	candlesticks := []Candlestick{
+		bar(15, 100, 101, 99, 100, 10),   // high below low
+		bar(14, 100, 99, 101, 100, 10),   // out of order
+		bar(16, 100, 99, 101, 0, 10),     // zero close
+		bar(17, 100, 99, 101, 100, 0),    // zero volume
+		bar(18, 103, 99, 101, 100, 10),   // open above high
+		bar(19, 100, 99, 9000, 8000, 10), // spike
+		bar(20, 100, 99, 101, 100, 10),
+		bar(20, 100, 99, 102, 101, 10), // duplicate
+	}
+
+	cs, issues := NewValidator(decimal.NewFromInt(50)).Validate(quote.Quote{ID: 1}, candlesticks, &previous)
+
+	expectedHours := []int{14, 15, 18, 20}
+	if len(cs) != len(expectedHours) {
+		t.Fatal(cs)
+	}
+	for i := range cs {
+		if cs[i].Timestamp.Hour() != expectedHours[i] {
+			t.Error(i, cs[i])
+		}
+	}
+	if !cs[1].High.Equals(decimal.NewFromInt(101)) || !cs[1].Low.Equals(decimal.NewFromInt(99)) {
+		t.Error(cs[1])
+	}
+	if !cs[2].High.Equals(decimal.NewFromInt(103)) {
+		t.Error(cs[2])
+	}
+	if !cs[3].Close.Equals(decimal.NewFromInt(101)) {
+		t.Error(cs[3])
+	}
+
+	expectedIssues := []IssueType{
+		IssueTypeHighBelowLow,
+		IssueTypeZeroPrice,
+		IssueTypeZeroVolume,
+		IssueTypeOutOfRange,
+		IssueTypePriceSpike,
+		IssueTypeDuplicate,
+	}
+	if len(issues) != len(expectedIssues) {
+		t.Fatal(issues)
+	}
+	for i := range issues {
+		if issues[i].Type != expectedIssues[i] {
+			t.Error(i, issues[i])
+		}
+	}
+
+	// the level holding after the first bar of a shift is real, only that first bar is quarantined
+	cs, issues = NewValidator(decimal.NewFromInt(50)).Validate(quote.Quote{ID: 1}, []Candlestick{
+		bar(14, 8000, 7900, 8100, 8000, 10),
+		bar(15, 8000, 7900, 8100, 8000, 10),
+		bar(16, 8000, 7900, 8100, 8000, 10),
+	}, &previous)
+	if len(cs) != 2 || cs[0].Timestamp.Hour() != 15 || len(issues) != 1 || issues[0].Type != IssueTypePriceSpike {
+		t.Error(cs, issues)
+	}
+
+	// a timestamp sent three times gets one issue
+	cs, issues = NewValidator(decimal.NewFromInt(50)).Validate(quote.Quote{ID: 1}, []Candlestick{
+		bar(14, 100, 99, 101, 100, 10),
+		bar(14, 100, 99, 102, 101, 10),
+		bar(14, 100, 99, 103, 102, 10),
+	}, &previous)
+	if len(cs) != 1 || !cs[0].Close.Equals(decimal.NewFromInt(102)) || len(issues) != 1 || issues[0].Type != IssueTypeDuplicate {
+		t.Error(cs, issues)
+	}
+
+	// forex has no volume
+	cs, issues = NewValidator(decimal.NewFromInt(50)).Validate(quote.Quote{AssetClass: quote.AssetClassForex}, candlesticks[3:4], nil)
+	if len(cs) != 1 || len(issues) != 1 || issues[0].Resolution != ResolutionFlagged {
+		t.Error(cs, issues)
+	}
+}
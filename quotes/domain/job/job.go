@@ -0,0 +1,151 @@
+package job
+
+import (
+	"errors"
+	"time"
+)
+
+type Type string
+
+const (
+	// TypeLatest loads the candlesticks appeared since the last stored ones
+	TypeLatest Type = "latest"
+	// TypeActions loads the corporate actions of the last days, they change far less often than the candlesticks
+	TypeActions Type = "actions"
+	// TypeBackfill reloads a range of the history
+	TypeBackfill Type = "backfill"
+	// TypeLoad loads the whole history of a new quote, the quote becomes ready after that
+	TypeLoad Type = "load"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusFailed    Status = "failed"
+	StatusSucceeded Status = "succeeded"
+)
+
+// ErrAbandoned is the error of a job whose worker stopped heartbeating.
+var ErrAbandoned = errors.New("the worker running the job has gone")
+
+// Job is a persisted ingestion task of one quote, failed runs are retried until MaxAttempts.
+type Job struct {
+	tableName struct{} `pg:"ingestion_jobs"`
+
+	ID      int64
+	Type    Type
+	QuoteID int64
+	// Symbol is the one the quote had when the job was created
+	Symbol string
+	// From and To are the range of a backfill
+	From        time.Time `pg:"range_from"`
+	To          time.Time `pg:"range_to"`
+	Status      Status
+	Attempts    int `pg:",use_zero"`
+	MaxAttempts int
+	Error       string
+	RunAt       time.Time
+	CreatedAt   time.Time
+	StartedAt   time.Time
+	// HeartbeatAt is renewed while the job runs, a running job without a recent one was abandoned
+	HeartbeatAt time.Time
+	FinishedAt  time.Time
+}
+
+func NewJob(t Type, quoteID int64, symbol string, maxAttempts int, now time.Time) Job {
+	return Job{
+		Type:        t,
+		QuoteID:     quoteID,
+		Symbol:      symbol,
+		Status:      StatusPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+	}
+}
+
+func (r Job) IsFinished() bool {
+	return r.Status == StatusFailed || r.Status == StatusSucceeded
+}
+
+func (r Job) HasAttemptsLeft() bool {
+	return r.Attempts < r.MaxAttempts
+}
+
+func (r *Job) Succeed(now time.Time) {
+	r.Status = StatusSucceeded
+	r.Error = ""
+	r.FinishedAt = now
+}
+
+// maxBackoffDoublings keeps the retry delay from overflowing with a large MaxAttempts
+const maxBackoffDoublings = 10
+
+// Fail puts the job back to pending after a backoff doubling with every attempt, or fails it after the last one.
+func (r *Job) Fail(err error, now time.Time, backoff time.Duration) {
+	r.Error = err.Error()
+	if !r.HasAttemptsLeft() {
+		r.Status = StatusFailed
+		r.FinishedAt = now
+		return
+	}
+
+	doublings := r.Attempts - 1
+	if doublings < 0 {
+		doublings = 0
+	}
+	if doublings > maxBackoffDoublings {
+		doublings = maxBackoffDoublings
+	}
+
+	r.Status = StatusPending
+	r.RunAt = now.Add(backoff << uint(doublings))
+}
+
+// Abort fails the job without retries, for errors that won't go away by themselves.
+func (r *Job) Abort(err error, now time.Time) {
+	r.Error = err.Error()
+	r.Status = StatusFailed
+	r.FinishedAt = now
+}
+
+// Filter selects jobs by the fields that are set.
+type Filter struct {
+	Type     Type
+	Statuses []Status
+	QuoteID  int64
+	Limit    int
+}
+
+type Repository interface {
+	CreateJob(job *Job) error
+	UpdateJob(job *Job) error
+	// TakeDueJob marks the earliest pending job due by now running and returns it, nil if there are none.
+	// The due jobs having no attempts left are failed instead of being taken.
+	TakeDueJob(now time.Time) (*Job, error)
+	// HeartbeatJob renews the heartbeat of the job while it is still running.
+	HeartbeatJob(job *Job, now time.Time) error
+	// FindJobs returns the latest jobs first.
+	FindJobs(filter Filter) ([]Job, error)
+	// ResetStaleJobs puts the running jobs without a heartbeat since staleBefore back to pending,
+	// the ones having no attempts left are failed with ErrAbandoned.
+	ResetStaleJobs(staleBefore, now time.Time) (int, error)
+}
+
+// Schedule runs every period at the offset from its start, like every hour at the first minute.
+type Schedule struct {
+	Every  time.Duration
+	Offset time.Duration
+}
+
+// Next returns the first run after t.
+func (r Schedule) Next(t time.Time) time.Time {
+	next := t.Truncate(r.Every).Add(r.Offset)
+	for !next.After(t) {
+		next = next.Add(r.Every)
+	}
+
+	return next
+}
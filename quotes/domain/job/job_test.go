@@ -0,0 +1,52 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	s := Schedule{Every: time.Hour, Offset: time.Minute}
+
+	now := time.Date(2021, 3, 1, 10, 0, 30, 0, time.UTC)
+	if next := s.Next(now); !next.Equal(time.Date(2021, 3, 1, 10, 1, 0, 0, time.UTC)) {
+		t.Error(next)
+	}
+
+	now = time.Date(2021, 3, 1, 10, 1, 0, 0, time.UTC)
+	if next := s.Next(now); !next.Equal(time.Date(2021, 3, 1, 11, 1, 0, 0, time.UTC)) {
+		t.Error(next)
+	}
+}
+
+func TestJob_Fail(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	j := NewJob(TypeLatest, 1, "AAPL", 3, now)
+
+	expected := []time.Time{now.Add(time.Minute), now.Add(2 * time.Minute)}
+	for i := range expected {
+		j.Attempts++
+		j.Fail(errors.New("timeout"), now, time.Minute)
+		if j.Status != StatusPending || !j.RunAt.Equal(expected[i]) {
+			t.Error(i, j.Status, j.RunAt)
+		}
+	}
+
+	j.Attempts++
+	j.Fail(errors.New("timeout"), now, time.Minute)
+	if j.Status != StatusFailed || j.Error != "timeout" || !j.FinishedAt.Equal(now) {
+		t.Error(j)
+	}
+}
+
+func TestJob_Fail_BackoffCapped(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	j := NewJob(TypeLatest, 1, "AAPL", 100, now)
+	j.Attempts = 80
+
+	j.Fail(errors.New("timeout"), now, time.Minute)
+	if j.Status != StatusPending || !j.RunAt.Equal(now.Add(1024*time.Minute)) {
+		t.Error(j.Status, j.RunAt)
+	}
+}
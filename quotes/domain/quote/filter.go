@@ -0,0 +1,9 @@
+package quote
+
+// Filter matches quotes by the fields that are set, empty ones match anything.
+type Filter struct {
+	Status     Status
+	Exchange   string
+	Currency   string
+	AssetClass AssetClass
+}
@@ -1,10 +1,25 @@
 package quote
 
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/calendar"
+)
+
 type Quote struct {
-	ID     int64
-	Symbol string
-	Name   string
-	Status Status
+	ID             int64
+	Symbol         string
+	Name           string
+	Status         Status
+	Provider       string `pg:",use_zero"`
+	ProviderSymbol string `pg:",use_zero"`
+	Exchange       string
+	Currency       string
+	AssetClass     AssetClass
+	Timezone       string
+	TradingHours   string
 }
 
 type Status string
@@ -14,3 +29,54 @@ const (
 	StatusReady     Status = "ready"
 	StatusSuspended Status = "suspended"
 )
+
+type AssetClass string
+
+const (
+	AssetClassStock  AssetClass = "stock"
+	AssetClassETF    AssetClass = "etf"
+	AssetClassFuture AssetClass = "future"
+	AssetClassForex  AssetClass = "forex"
+	AssetClassCrypto AssetClass = "crypto"
+)
+
+// TradingHoursAlways marks instruments trading around the clock, like crypto.
+const TradingHoursAlways = calendar.TradingHoursAlways
+
+// GetProviderSymbol returns the symbol the market data provider knows the quote by.
+func (r Quote) GetProviderSymbol() string {
+	if r.ProviderSymbol != "" {
+		return r.ProviderSymbol
+	}
+
+	return r.Symbol
+}
+
+func (r Quote) IsAlwaysTrading() bool {
+	return r.TradingHours == TradingHoursAlways
+}
+
+func (r Quote) GetLocation() (*time.Location, error) {
+	location, err := time.LoadLocation(r.Timezone)
+	return location, errors.Wrapf(err, "quote %s timezone", r.Symbol)
+}
+
+// GetTradingHours parses hours like "09:30-16:00" into offsets from the local midnight.
+func (r Quote) GetTradingHours() (open, close time.Duration, err error) {
+	return calendar.ParseTradingHours(r.TradingHours)
+}
+
+// GetCalendar picks the calendar by the exchange, the quotes without metadata trade by the NYSE one.
+func (r Quote) GetCalendar() (calendar.Calendar, error) {
+	c, err := calendar.ForMarket(r.Exchange, r.Timezone, r.TradingHours)
+	return c, errors.Wrapf(err, "quote %s", r.Symbol)
+}
+
+func IsKnownAssetClass(assetClass AssetClass) bool {
+	switch assetClass {
+	case AssetClassStock, AssetClassETF, AssetClassFuture, AssetClassForex, AssetClassCrypto:
+		return true
+	}
+
+	return false
+}
@@ -2,6 +2,12 @@ package quote
 
 type Repository interface {
 	GetQuotes(status Status) ([]Quote, error)
+	FindQuotes(filter Filter) ([]Quote, error)
 	GetQuote(symbol string) (*Quote, error)
+	GetQuoteByID(id int64) (*Quote, error)
 	UpdateQuoteStatus(quote *Quote, status Status) error
+	CreateQuote(quote *Quote) error
+	UpdateQuote(quote *Quote) error
+	// DeleteQuote deletes the quote together with its candlesticks, their issues, corporate actions and ingestion jobs.
+	DeleteQuote(quote *Quote) error
 }
@@ -13,6 +13,7 @@ require (
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.3.0
 	github.com/shopspring/decimal v1.2.0
+	github.com/websmee/example_of_my_code/calendar v0.0.0
 	github.com/websmee/ms v0.0.0-20210307191836-63e0d524c105
 	golang.org/x/net v0.0.0-20201224014010-6772e930b67b // indirect
 	golang.org/x/sys v0.0.0-20210105210732-16f7687f5001 // indirect
@@ -21,3 +22,5 @@ require (
 	google.golang.org/grpc v1.34.0
 	google.golang.org/protobuf v1.25.0
 )
+
+replace github.com/websmee/example_of_my_code/calendar => ../calendar
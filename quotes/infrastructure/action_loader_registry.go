@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type actionLoaderRegistry struct {
+	loaders         map[string]action.Loader
+	defaultProvider string
+}
+
+// NewActionLoaderRegistry passes every call to the loader of the quote's provider like NewCandlestickLoaderRegistry,
+// but providers without corporate actions just have none.
+func NewActionLoaderRegistry(loaders map[string]action.Loader, defaultProvider string) action.Loader {
+	return &actionLoaderRegistry{
+		loaders:         loaders,
+		defaultProvider: defaultProvider,
+	}
+}
+
+func (r actionLoaderRegistry) LoadActions(quote quote.Quote, start, end time.Time) ([]action.Action, error) {
+	provider := quote.Provider
+	if provider == "" {
+		provider = r.defaultProvider
+	}
+
+	loader, ok := r.loaders[provider]
+	if !ok {
+		return nil, nil
+	}
+
+	return loader.LoadActions(quote, start, end)
+}
@@ -0,0 +1,61 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+const (
+	ProviderTiingo = "tiingo"
+	ProviderCSV    = "csv"
+)
+
+type candlestickLoaderRegistry struct {
+	loaders         map[string]candlestick.Loader
+	defaultProvider string
+}
+
+// NewCandlestickLoaderRegistry returns a loader that passes every call to the loader of the quote's provider.
+// Quotes without a provider are loaded by the default one.
+func NewCandlestickLoaderRegistry(loaders map[string]candlestick.Loader, defaultProvider string) candlestick.Loader {
+	return &candlestickLoaderRegistry{
+		loaders:         loaders,
+		defaultProvider: defaultProvider,
+	}
+}
+
+func (r candlestickLoaderRegistry) LoadHistory(quote quote.Quote, start, end time.Time, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	loader, err := r.getLoader(quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return loader.LoadHistory(quote, start, end, interval)
+}
+
+func (r candlestickLoaderRegistry) LoadLatest(quote quote.Quote, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	loader, err := r.getLoader(quote)
+	if err != nil {
+		return nil, err
+	}
+
+	return loader.LoadLatest(quote, interval)
+}
+
+func (r candlestickLoaderRegistry) getLoader(quote quote.Quote) (candlestick.Loader, error) {
+	provider := quote.Provider
+	if provider == "" {
+		provider = r.defaultProvider
+	}
+
+	loader, ok := r.loaders[provider]
+	if !ok {
+		return nil, errors.Errorf("no candlestick loader for provider %q of %s", provider, quote.Symbol)
+	}
+
+	return loader, nil
+}
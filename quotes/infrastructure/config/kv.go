@@ -10,12 +10,16 @@ import (
 )
 
 const (
-	tiingoKey = "tiingo"
+	tiingoKey    = "tiingo"
+	providersKey = "providers"
+
+	defaultProvider = "tiingo"
 )
 
 type Config interface {
 	GetDB(key string) (*DB, error)
 	GetTiingo() (*Tiingo, error)
+	GetProviders() (*Providers, error)
 }
 
 type consulKVConfig struct {
@@ -61,3 +65,22 @@ func (r *consulKVConfig) GetTiingo() (*Tiingo, error) {
 
 	return &t, nil
 }
+
+func (r *consulKVConfig) GetProviders() (*Providers, error) {
+	p := Providers{Default: defaultProvider}
+
+	data, err := r.kv.Get(providersKey)
+	if err != nil {
+		if _, ok := err.(*configKV.ErrorKeyNotFound); ok {
+			return &p, nil
+		}
+		return nil, errors.Wrap(err, "GetProviders config failed get")
+	}
+
+	err = json.Unmarshal(data, &p)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetProviders config failed unmarshal")
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,32 @@
+package config
+
+type Providers struct {
+	Default string                  `json:"default"`
+	CSV     CSVProvider             `json:"csv"`
+	HTTP    map[string]HTTPProvider `json:"http"`
+}
+
+type CSVProvider struct {
+	Path string `json:"path"`
+}
+
+// HTTPProvider describes a JSON-over-HTTP market data API.
+// URL may contain {symbol}, {interval}, {from} and {to} placeholders,
+// {from} and {to} are formatted with TimestampFormat.
+type HTTPProvider struct {
+	URL             string             `json:"url"`
+	Headers         map[string]string  `json:"headers"`
+	Intervals       map[string]string  `json:"intervals"`
+	DataPath        string             `json:"data_path"`
+	TimestampFormat string             `json:"timestamp_format"`
+	Fields          HTTPProviderFields `json:"fields"`
+}
+
+type HTTPProviderFields struct {
+	Timestamp string `json:"timestamp"`
+	Open      string `json:"open"`
+	High      string `json:"high"`
+	Low       string `json:"low"`
+	Close     string `json:"close"`
+	Volume    string `json:"volume"`
+}
@@ -1,5 +1,7 @@
 package config
 
 type Tiingo struct {
-	Token string `json:"token"`
+	Token           string `json:"token"`
+	RequestsPerHour int    `json:"requests_per_hour"`
+	MaxRetries      int    `json:"max_retries"`
 }
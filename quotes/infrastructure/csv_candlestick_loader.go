@@ -0,0 +1,113 @@
+package infrastructure
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+const csvDateLayout = "2006-01-02"
+
+type csvCandlestickLoader struct {
+	filePath string
+}
+
+// NewCSVCandlestickLoader reads candlesticks from <filePath><symbol>_<interval>.csv files.
+// Every file starts with a header row followed by date,open,high,low,close,volume records,
+// dates are either RFC3339 or 2006-01-02.
+func NewCSVCandlestickLoader(filePath string) candlestick.Loader {
+	return &csvCandlestickLoader{
+		filePath: filePath,
+	}
+}
+
+func (r csvCandlestickLoader) LoadHistory(quote quote.Quote, start, end time.Time, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	f, err := os.Open(r.getFilepath(quote.GetProviderSymbol(), interval))
+	if err != nil {
+		return nil, errors.Wrap(err, "LoadHistory file open failed")
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	if _, err := reader.Read(); err != nil {
+		return nil, errors.Wrap(err, "LoadHistory header read failed")
+	}
+
+	var candlesticks []candlestick.Candlestick
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "LoadHistory file read failed")
+		}
+
+		c, err := recordToCandlestick(record, quote.ID, interval)
+		if err != nil {
+			return nil, err
+		}
+		if c.Timestamp.Before(start) || c.Timestamp.After(end) {
+			continue
+		}
+		candlesticks = append(candlesticks, c)
+	}
+
+	return candlesticks, nil
+}
+
+func (r csvCandlestickLoader) LoadLatest(quote quote.Quote, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	return r.LoadHistory(quote, time.Now().Add(-latestPeriod(interval)), time.Now(), interval)
+}
+
+func (r csvCandlestickLoader) getFilepath(symbol string, interval candlestick.Interval) string {
+	return r.filePath + strings.ReplaceAll(symbol, "=", "_") + "_" + string(interval) + ".csv"
+}
+
+func recordToCandlestick(record []string, quoteID int64, interval candlestick.Interval) (candlestick.Candlestick, error) {
+	if len(record) < 6 {
+		return candlestick.Candlestick{}, errors.Errorf("record %v has %d fields, 6 expected", record, len(record))
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, record[0])
+	if err != nil {
+		timestamp, err = time.Parse(csvDateLayout, record[0])
+		if err != nil {
+			return candlestick.Candlestick{}, errors.Wrap(err, "record date parsing failed")
+		}
+	}
+
+	var prices [4]decimal.Decimal
+	for i := range prices {
+		prices[i], err = decimal.NewFromString(record[i+1])
+		if err != nil {
+			return candlestick.Candlestick{}, errors.Wrap(err, "record price parsing failed")
+		}
+	}
+
+	volume, err := strconv.ParseFloat(record[5], 64)
+	if err != nil {
+		return candlestick.Candlestick{}, errors.Wrap(err, "record volume parsing failed")
+	}
+
+	return candlestick.Candlestick{
+		Open:      prices[0],
+		High:      prices[1],
+		Low:       prices[2],
+		Close:     prices[3],
+		AdjClose:  decimal.NewFromInt(0),
+		Volume:    int(volume),
+		Timestamp: timestamp.UTC(),
+		Interval:  interval,
+		QuoteID:   quoteID,
+	}, nil
+}
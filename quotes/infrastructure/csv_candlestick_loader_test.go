@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+func TestCSVCandlestickLoader_LoadHistory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csv_loader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := "date,open,high,low,close,volume\n" +
+		"2021-01-04,1,2,0.5,1.5,100\n" +
+		"2021-01-05T14:30:00Z,1.5,2.5,1,2,200.0\n" +
+		"2021-01-06,2,3,1.5,2.5,300\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "EURUSD_X_1d.csv"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := NewCSVCandlestickLoader(dir + string(filepath.Separator))
+	q := quote.Quote{ID: 7, Symbol: "EURUSD", ProviderSymbol: "EURUSD=X"}
+	candlesticks, err := loader.LoadHistory(
+		q,
+		time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 5, 23, 0, 0, 0, time.UTC),
+		candlestick.IntervalDay,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(candlesticks) != 2 {
+		t.Fatal(candlesticks)
+	}
+	c := candlesticks[1]
+	if !c.Timestamp.Equal(time.Date(2021, 1, 5, 14, 30, 0, 0, time.UTC)) || c.Close.String() != "2" ||
+		c.Volume != 200 || c.QuoteID != 7 || c.Interval != candlestick.IntervalDay {
+		t.Error(c)
+	}
+}
+
+func TestCSVCandlestickLoader_LoadHistory_InvalidRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csv_loader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	data := "date,open,high,low,close,volume\n2021-01-04,1,2,x,1.5,100\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "AAPL_1h.csv"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := NewCSVCandlestickLoader(dir + string(filepath.Separator))
+	_, err = loader.LoadHistory(quote.Quote{Symbol: "AAPL"}, time.Time{}, time.Now(), candlestick.IntervalHour)
+	if err == nil {
+		t.Error("invalid price loaded")
+	}
+}
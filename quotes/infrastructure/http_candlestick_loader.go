@@ -0,0 +1,220 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
+)
+
+const (
+	httpTimestampFormatUnix   = "unix"
+	httpTimestampFormatUnixMS = "unix_ms"
+
+	httpLoaderTimeout = time.Minute
+)
+
+type httpCandlestickLoader struct {
+	httpClient http.Client
+	cfg        config.HTTPProvider
+}
+
+// NewHTTPCandlestickLoader loads candlesticks from any API that returns them as an array of JSON objects,
+// the request URL and the response fields are described by the provider config.
+func NewHTTPCandlestickLoader(cfg config.HTTPProvider) candlestick.Loader {
+	return &httpCandlestickLoader{
+		httpClient: http.Client{Timeout: httpLoaderTimeout},
+		cfg:        cfg,
+	}
+}
+
+func (r httpCandlestickLoader) LoadHistory(quote quote.Quote, start, end time.Time, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	responseBody, err := r.makeRequest(quote.GetProviderSymbol(), start, end, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.getItems(responseBody)
+	if err != nil {
+		return nil, err
+	}
+
+	candlesticks := make([]candlestick.Candlestick, len(items))
+	for i := range items {
+		candlesticks[i], err = r.itemToCandlestick(items[i], quote.ID, interval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return candlesticks, nil
+}
+
+func (r httpCandlestickLoader) LoadLatest(quote quote.Quote, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
+	return r.LoadHistory(quote, time.Now().Add(-latestPeriod(interval)), time.Now(), interval)
+}
+
+func (r httpCandlestickLoader) makeRequest(symbol string, start, end time.Time, interval candlestick.Interval) ([]byte, error) {
+	providerInterval, ok := r.cfg.Intervals[string(interval)]
+	if !ok {
+		providerInterval = string(interval)
+	}
+
+	u := strings.NewReplacer(
+		"{symbol}", url.QueryEscape(symbol),
+		"{interval}", url.QueryEscape(providerInterval),
+		"{from}", url.QueryEscape(r.formatTimestamp(start)),
+		"{to}", url.QueryEscape(r.formatTimestamp(end)),
+	).Replace(r.cfg.URL)
+
+	request, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "makeRequest failed")
+	}
+
+	request.Header.Add("Accept", "application/json")
+	for k, v := range r.cfg.Headers {
+		request.Header.Add(k, v)
+	}
+
+	response, err := r.httpClient.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "makeRequest do failed")
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "makeRequest read failed")
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("makeRequest failed with status %d: %s", response.StatusCode, responseBody)
+	}
+
+	return responseBody, nil
+}
+
+func (r httpCandlestickLoader) getItems(responseBody []byte) ([]map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(responseBody))
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, errors.Wrap(err, "getItems unmarshal failed")
+	}
+
+	if r.cfg.DataPath != "" {
+		for _, key := range strings.Split(r.cfg.DataPath, ".") {
+			object, ok := data.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("getItems failed: %q is not an object", key)
+			}
+			data = object[key]
+		}
+	}
+
+	array, ok := data.([]interface{})
+	if !ok {
+		return nil, errors.New("getItems failed: data is not an array")
+	}
+
+	items := make([]map[string]interface{}, len(array))
+	for i := range array {
+		items[i], ok = array[i].(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("getItems failed: item %d is not an object", i)
+		}
+	}
+
+	return items, nil
+}
+
+func (r httpCandlestickLoader) itemToCandlestick(item map[string]interface{}, quoteID int64, interval candlestick.Interval) (candlestick.Candlestick, error) {
+	timestamp, err := r.parseTimestamp(item[r.cfg.Fields.Timestamp])
+	if err != nil {
+		return candlestick.Candlestick{}, err
+	}
+
+	fields := []string{r.cfg.Fields.Open, r.cfg.Fields.High, r.cfg.Fields.Low, r.cfg.Fields.Close, r.cfg.Fields.Volume}
+	values := make([]decimal.Decimal, len(fields))
+	for i := range fields {
+		values[i], err = toDecimal(item[fields[i]])
+		if err != nil {
+			return candlestick.Candlestick{}, errors.Wrapf(err, "field %q parsing failed", fields[i])
+		}
+	}
+
+	return candlestick.Candlestick{
+		Open:      values[0],
+		High:      values[1],
+		Low:       values[2],
+		Close:     values[3],
+		AdjClose:  decimal.NewFromInt(0),
+		Volume:    int(values[4].IntPart()),
+		Timestamp: timestamp.UTC(),
+		Interval:  interval,
+		QuoteID:   quoteID,
+	}, nil
+}
+
+func (r httpCandlestickLoader) formatTimestamp(t time.Time) string {
+	switch r.cfg.TimestampFormat {
+	case httpTimestampFormatUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case httpTimestampFormatUnixMS:
+		return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+	case "":
+		return t.UTC().Format(time.RFC3339)
+	default:
+		return t.UTC().Format(r.cfg.TimestampFormat)
+	}
+}
+
+func (r httpCandlestickLoader) parseTimestamp(v interface{}) (time.Time, error) {
+	switch r.cfg.TimestampFormat {
+	case httpTimestampFormatUnix, httpTimestampFormatUnixMS:
+		d, err := toDecimal(v)
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, "timestamp parsing failed")
+		}
+		if r.cfg.TimestampFormat == httpTimestampFormatUnixMS {
+			return time.Unix(0, d.IntPart()*int64(time.Millisecond)), nil
+		}
+		return time.Unix(d.IntPart(), 0), nil
+	default:
+		s, ok := v.(string)
+		if !ok {
+			return time.Time{}, errors.Errorf("timestamp %v is not a string", v)
+		}
+		layout := r.cfg.TimestampFormat
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		t, err := time.Parse(layout, s)
+		return t, errors.Wrap(err, "timestamp parsing failed")
+	}
+}
+
+// toDecimal accepts both JSON numbers and numeric strings, many crypto APIs send prices as strings
+func toDecimal(v interface{}) (decimal.Decimal, error) {
+	switch value := v.(type) {
+	case json.Number:
+		return decimal.NewFromString(value.String())
+	case string:
+		return decimal.NewFromString(value)
+	default:
+		return decimal.Decimal{}, errors.Errorf("%v is not a number", v)
+	}
+}
@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
+)
+
+func TestHTTPCandlestickLoader_LoadHistory(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		if r.Header.Get("X-Key") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"result":{"candles":[
+			{"t":1609770600,"o":"1.1","h":1.2,"l":1.0,"c":"1.15","v":100},
+			{"t":1609774200,"o":1.15,"h":1.3,"l":1.1,"c":1.25,"v":"250"}
+		]}}`))
+	}))
+	defer server.Close()
+
+	loader := NewHTTPCandlestickLoader(config.HTTPProvider{
+		URL:             server.URL + "/candles?symbol={symbol}&interval={interval}&from={from}&to={to}",
+		Headers:         map[string]string{"X-Key": "secret"},
+		Intervals:       map[string]string{"1h": "60"},
+		DataPath:        "result.candles",
+		TimestampFormat: "unix",
+		Fields: config.HTTPProviderFields{
+			Timestamp: "t", Open: "o", High: "h", Low: "l", Close: "c", Volume: "v",
+		},
+	})
+	start := time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC)
+	candlesticks, err := loader.LoadHistory(quote.Quote{ID: 3, Symbol: "BTCUSD", ProviderSymbol: "BTC/USD"}, start, end, candlestick.IntervalHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query != "symbol=BTC%2FUSD&interval=60&from=1609718400&to=1609804800" {
+		t.Error(query)
+	}
+	if len(candlesticks) != 2 {
+		t.Fatal(candlesticks)
+	}
+	c := candlesticks[0]
+	if !c.Timestamp.Equal(time.Date(2021, 1, 4, 14, 30, 0, 0, time.UTC)) || c.Open.String() != "1.1" ||
+		c.Close.String() != "1.15" || c.Volume != 100 || c.QuoteID != 3 {
+		t.Error(c)
+	}
+	if candlesticks[1].Volume != 250 {
+		t.Error(candlesticks[1])
+	}
+}
+
+func TestHTTPCandlestickLoader_LoadHistory_NotAnArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"candles":null}}`))
+	}))
+	defer server.Close()
+
+	loader := NewHTTPCandlestickLoader(config.HTTPProvider{URL: server.URL, DataPath: "result.candles"})
+	if _, err := loader.LoadHistory(quote.Quote{Symbol: "AAPL"}, time.Now(), time.Now(), candlestick.IntervalHour); err == nil {
+		t.Error("no error on null data")
+	}
+}
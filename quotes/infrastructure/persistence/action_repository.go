@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type ActionRepository struct {
+	db *pg.DB
+}
+
+func NewActionRepository(db *pg.DB) *ActionRepository {
+	return &ActionRepository{db}
+}
+
+func (r ActionRepository) SaveActions(actions []action.Action) error {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	_, err := r.db.Model(&actions).
+		OnConflict("(quote_id, type, ex_date) DO UPDATE").
+		Set("ratio = EXCLUDED.ratio").
+		Set("amount = EXCLUDED.amount").
+		Set("factor = EXCLUDED.factor").
+		Insert()
+
+	return errors.Wrap(err, "SaveActions failed")
+}
+
+func (r ActionRepository) GetActions(quote *quote.Quote, from time.Time) ([]action.Action, error) {
+	var actions []action.Action
+
+	err := r.db.Model(&actions).
+		Where("quote_id = ?", quote.ID).
+		Where("ex_date > ?", from).
+		Order("ex_date ASC").
+		Select()
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetActions failed")
+	}
+
+	return actions, nil
+}
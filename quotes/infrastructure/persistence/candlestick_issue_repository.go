@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+type CandlestickIssueRepository struct {
+	db *pg.DB
+}
+
+func NewCandlestickIssueRepository(db *pg.DB) *CandlestickIssueRepository {
+	return &CandlestickIssueRepository{db}
+}
+
+func (r CandlestickIssueRepository) SaveIssues(issues []candlestick.Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	_, err := r.db.Model(&issues).
+		OnConflict("(quote_id, interval, timestamp, type) DO UPDATE").
+		Set("resolution = EXCLUDED.resolution").
+		Set("details = EXCLUDED.details").
+		Set("created_at = EXCLUDED.created_at").
+		Insert()
+
+	return errors.Wrap(err, "SaveIssues failed")
+}
+
+func (r CandlestickIssueRepository) GetIssues(quote *quote.Quote, interval candlestick.Interval, from, to time.Time) ([]candlestick.Issue, error) {
+	var issues []candlestick.Issue
+
+	err := r.db.Model(&issues).
+		Where("quote_id = ?", quote.ID).
+		Where("interval = ?", interval).
+		Where("timestamp >= ?", from).
+		Where("timestamp <= ?", to).
+		Order("timestamp ASC", "type ASC").
+		Select()
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetIssues failed")
+	}
+
+	return issues, nil
+}
+
+func (r CandlestickIssueRepository) GetQuarantinedTimestamps(quote *quote.Quote, interval candlestick.Interval, from, to time.Time) ([]time.Time, error) {
+	var timestamps []time.Time
+
+	err := r.db.Model((*candlestick.Issue)(nil)).
+		ColumnExpr("DISTINCT timestamp").
+		Where("quote_id = ?", quote.ID).
+		Where("interval = ?", interval).
+		Where("resolution = ?", candlestick.ResolutionQuarantined).
+		Where("timestamp >= ?", from).
+		Where("timestamp <= ?", to).
+		Order("timestamp ASC").
+		Select(&timestamps)
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetQuarantinedTimestamps failed")
+	}
+
+	return timestamps, nil
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/websmee/example_of_my_code/quotes/domain/quote"
 )
 
+// saveBatchSize keeps a multi-row insert statement of a reasonable size
+const saveBatchSize = 1000
+
 type CandlestickRepository struct {
 	db *pg.DB
 }
@@ -32,6 +35,41 @@ func (r CandlestickRepository) SaveCandlestick(candlestick *candlestick.Candlest
 	return errors.Wrap(err, "SaveCandlestick failed")
 }
 
+// SaveCandlesticks upserts the candlesticks in one transaction, saveBatchSize rows per statement.
+// The candlesticks must not repeat, one statement can't update the same row twice.
+func (r CandlestickRepository) SaveCandlesticks(candlesticks []candlestick.Candlestick) error {
+	if len(candlesticks) == 0 {
+		return nil
+	}
+
+	err := r.db.RunInTransaction(func(tx *pg.Tx) error {
+		for start := 0; start < len(candlesticks); start += saveBatchSize {
+			end := start + saveBatchSize
+			if end > len(candlesticks) {
+				end = len(candlesticks)
+			}
+
+			batch := candlesticks[start:end]
+			_, err := tx.Model(&batch).
+				OnConflict("(quote_id, interval, timestamp) DO UPDATE").
+				Set("open = EXCLUDED.open").
+				Set("low = EXCLUDED.low").
+				Set("high = EXCLUDED.high").
+				Set("close = EXCLUDED.close").
+				Set("adj_close = EXCLUDED.adj_close").
+				Set("volume = EXCLUDED.volume").
+				Insert()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return errors.Wrap(err, "SaveCandlesticks failed")
+}
+
 func (r CandlestickRepository) GetCandlesticks(quote *quote.Quote, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error) {
 	var candlesticks []candlestick.Candlestick
 
@@ -50,6 +88,91 @@ func (r CandlestickRepository) GetCandlesticks(quote *quote.Quote, interval cand
 	return candlesticks, nil
 }
 
+func (r CandlestickRepository) GetCandlesticksPage(
+	quote *quote.Quote,
+	interval candlestick.Interval,
+	from, to time.Time,
+	limit int,
+) ([]candlestick.Candlestick, error) {
+	var candlesticks []candlestick.Candlestick
+
+	err := r.db.Model(&candlestick.Candlestick{}).
+		Where("quote_id = ?", quote.ID).
+		Where("interval = ?", interval).
+		Where("timestamp >= ?", from).
+		Where("timestamp <= ?", to).
+		Order("timestamp ASC").
+		Limit(limit).
+		Select(&candlesticks)
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetCandlesticksPage failed")
+	}
+
+	return candlesticks, nil
+}
+
+func (r CandlestickRepository) GetCandlesticksBatch(quotes []quote.Quote, interval candlestick.Interval, from, to time.Time) ([]candlestick.Candlestick, error) {
+	var candlesticks []candlestick.Candlestick
+	if len(quotes) == 0 {
+		return candlesticks, nil
+	}
+
+	quoteIDs := make([]int64, len(quotes))
+	for i := range quotes {
+		quoteIDs[i] = quotes[i].ID
+	}
+
+	err := r.db.Model(&candlestick.Candlestick{}).
+		Where("quote_id IN (?)", pg.In(quoteIDs)).
+		Where("interval = ?", interval).
+		Where("timestamp >= ?", from).
+		Where("timestamp <= ?", to).
+		Order("quote_id ASC", "timestamp ASC").
+		Select(&candlesticks)
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetCandlesticksBatch failed")
+	}
+
+	return candlesticks, nil
+}
+
+func (r CandlestickRepository) GetCandlesticksByCount(
+	quote *quote.Quote,
+	interval candlestick.Interval,
+	start time.Time,
+	direction candlestick.Direction,
+	count int,
+) ([]candlestick.Candlestick, error) {
+	var candlesticks []candlestick.Candlestick
+
+	query := r.db.Model(&candlestick.Candlestick{}).
+		Where("quote_id = ?", quote.ID).
+		Where("interval = ?", interval).
+		Limit(count)
+	switch direction {
+	case candlestick.DirectionForward:
+		query = query.Where("timestamp >= ?", start).Order("timestamp ASC")
+	case candlestick.DirectionBackward:
+		query = query.Where("timestamp <= ?", start).Order("timestamp DESC")
+	default:
+		return nil, errors.Errorf("unknown direction %q", direction)
+	}
+
+	if err := query.Select(&candlesticks); err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetCandlesticksByCount failed")
+	}
+
+	if direction == candlestick.DirectionBackward {
+		for i, j := 0, len(candlesticks)-1; i < j; i, j = i+1, j-1 {
+			candlesticks[i], candlesticks[j] = candlesticks[j], candlesticks[i]
+		}
+	}
+
+	return candlesticks, nil
+}
+
 func (r CandlestickRepository) GetLastCandlestickTimestamp(quote *quote.Quote, interval candlestick.Interval) (time.Time, error) {
 	var toReturn struct {
 		Timestamp time.Time
@@ -60,16 +183,35 @@ func (r CandlestickRepository) GetLastCandlestickTimestamp(quote *quote.Quote, i
 		TableExpr("candlesticks").
 		Where("quote_id = ?", quote.ID).
 		Where("interval = ?", interval).
-		Order("timestamp ASC").
+		Order("timestamp DESC").
 		Limit(1).
 		Select(&toReturn)
 
 	if err != nil {
 		if err == pg.ErrNoRows {
-			return time.Now(), nil
+			return time.Time{}, nil
 		}
-		return time.Now(), errors.Wrap(err, "GetLastCandlestickTimestamp failed")
+		return time.Time{}, errors.Wrap(err, "GetLastCandlestickTimestamp failed")
 	}
 
 	return toReturn.Timestamp, nil
 }
+
+func (r CandlestickRepository) GetCandlestickTimestamps(quote *quote.Quote, interval candlestick.Interval, from, to time.Time) ([]time.Time, error) {
+	var timestamps []time.Time
+
+	err := r.db.Model((*candlestick.Candlestick)(nil)).
+		Column("timestamp").
+		Where("quote_id = ?", quote.ID).
+		Where("interval = ?", interval).
+		Where("timestamp >= ?", from).
+		Where("timestamp <= ?", to).
+		Order("timestamp ASC").
+		Select(&timestamps)
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetCandlestickTimestamps failed")
+	}
+
+	return timestamps, nil
+}
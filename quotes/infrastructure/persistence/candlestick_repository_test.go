@@ -0,0 +1,42 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/candlestick"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+func TestCandlestickRepository_GetCandlesticksByCount(t *testing.T) {
+	db, capture := newCapturingDB()
+	defer db.Close()
+
+	repo := NewCandlestickRepository(db)
+	start := time.Date(2021, 3, 1, 14, 30, 0, 0, time.UTC)
+	for _, direction := range []candlestick.Direction{candlestick.DirectionForward, candlestick.DirectionBackward} {
+		if _, err := repo.GetCandlesticksByCount(&quote.Quote{ID: 1}, candlestick.IntervalHour, start, direction, 5); err == nil {
+			t.Fatal(direction)
+		}
+	}
+
+	if len(capture.queries) != 2 {
+		t.Fatal(capture.queries)
+	}
+
+	for i, expected := range [][]string{
+		{`(timestamp >= '2021-03-01 14:30:00`, `ORDER BY "timestamp" ASC`, "LIMIT 5"},
+		{`(timestamp <= '2021-03-01 14:30:00`, `ORDER BY "timestamp" DESC`, "LIMIT 5"},
+	} {
+		for _, part := range expected {
+			if !strings.Contains(capture.queries[i], part) {
+				t.Error(part, capture.queries[i])
+			}
+		}
+	}
+
+	if _, err := repo.GetCandlesticksByCount(&quote.Quote{ID: 1}, candlestick.IntervalHour, start, "sideways", 5); err == nil || len(capture.queries) != 2 {
+		t.Error("unknown direction", err)
+	}
+}
@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/job"
+)
+
+type JobRepository struct {
+	db *pg.DB
+}
+
+func NewJobRepository(db *pg.DB) *JobRepository {
+	return &JobRepository{db}
+}
+
+func (r JobRepository) CreateJob(j *job.Job) error {
+	_, err := r.db.Model(j).Returning("*").Insert()
+
+	return errors.Wrap(err, "CreateJob failed")
+}
+
+func (r JobRepository) UpdateJob(j *job.Job) error {
+	_, err := r.db.Model(j).WherePK().Update()
+
+	return errors.Wrap(err, "UpdateJob failed")
+}
+
+// TakeDueJob skips the rows locked by another instance taking a job at the same time.
+func (r JobRepository) TakeDueJob(now time.Time) (*job.Job, error) {
+	var taken *job.Job
+	err := r.db.RunInTransaction(func(tx *pg.Tx) error {
+		j := &job.Job{}
+		for {
+			err := tx.Model(j).
+				Where("status = ?", job.StatusPending).
+				Where("run_at <= ?", now).
+				Order("run_at ASC", "id ASC").
+				Limit(1).
+				For("UPDATE SKIP LOCKED").
+				Select()
+			if err == pg.ErrNoRows {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if j.HasAttemptsLeft() {
+				break
+			}
+
+			// a job reset before its attempts were checked, its last worker has gone
+			j.Abort(job.ErrAbandoned, now)
+			if _, err := tx.Model(j).Column("status", "error", "finished_at").WherePK().Update(); err != nil {
+				return err
+			}
+			j = &job.Job{}
+		}
+
+		j.Status = job.StatusRunning
+		j.Attempts++
+		j.StartedAt = now
+		j.HeartbeatAt = now
+		if _, err := tx.Model(j).Column("status", "attempts", "started_at", "heartbeat_at").WherePK().Update(); err != nil {
+			return err
+		}
+
+		taken = j
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "TakeDueJob failed")
+	}
+
+	return taken, nil
+}
+
+func (r JobRepository) HeartbeatJob(j *job.Job, now time.Time) error {
+	_, err := r.db.Model(j).
+		Set("heartbeat_at = ?", now).
+		WherePK().
+		Where("status = ?", job.StatusRunning).
+		Update()
+	if err != nil {
+		return errors.Wrap(err, "HeartbeatJob failed")
+	}
+
+	j.HeartbeatAt = now
+	return nil
+}
+
+func (r JobRepository) FindJobs(filter job.Filter) ([]job.Job, error) {
+	var jobs []job.Job
+
+	query := r.db.Model(&jobs)
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.WhereIn("status IN (?)", filter.Statuses)
+	}
+	if filter.QuoteID != 0 {
+		query = query.Where("quote_id = ?", filter.QuoteID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	err := query.Order("id DESC").Select()
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "FindJobs failed")
+	}
+
+	return jobs, nil
+}
+
+// ResetStaleJobs doesn't touch the jobs other instances are running, they keep heartbeating.
+func (r JobRepository) ResetStaleJobs(staleBefore, now time.Time) (int, error) {
+	res, err := r.db.Model(&job.Job{}).
+		Set("status = CASE WHEN attempts >= max_attempts THEN ? ELSE ? END", job.StatusFailed, job.StatusPending).
+		Set("error = CASE WHEN attempts >= max_attempts THEN ? ELSE error END", job.ErrAbandoned.Error()).
+		Set("finished_at = CASE WHEN attempts >= max_attempts THEN ? ELSE finished_at END", now).
+		Where("status = ?", job.StatusRunning).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.WhereOr("heartbeat_at < ?", staleBefore).WhereOr("heartbeat_at IS NULL"), nil
+		}).
+		Update()
+	if err != nil {
+		return 0, errors.Wrap(err, "ResetStaleJobs failed")
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestJobRepository_ResetStaleJobs(t *testing.T) {
+	db, capture := newCapturingDB()
+	defer db.Close()
+
+	staleBefore := time.Date(2021, 3, 1, 14, 30, 0, 0, time.UTC)
+	_, err := NewJobRepository(db).ResetStaleJobs(staleBefore, staleBefore.Add(5*time.Minute))
+	if errors.Cause(err) != errQueryCaptured || len(capture.queries) != 1 {
+		t.Fatal(err, capture.queries)
+	}
+
+	q := capture.queries[0]
+	for _, expected := range []string{
+		`status = 'running'`,
+		`(heartbeat_at < '2021-03-01 14:30:00+00:00:00') OR (heartbeat_at IS NULL)`,
+		// the jobs having no attempts left fail instead of being run once more
+		`status = CASE WHEN attempts >= max_attempts THEN 'failed' ELSE 'pending' END`,
+		`error = CASE WHEN attempts >= max_attempts THEN 'the worker running the job has gone' ELSE error END`,
+		`finished_at = CASE WHEN attempts >= max_attempts THEN '2021-03-01 14:35:00+00:00:00' ELSE finished_at END`,
+	} {
+		if !strings.Contains(q, expected) {
+			t.Errorf("expected %s in %s", expected, q)
+		}
+	}
+}
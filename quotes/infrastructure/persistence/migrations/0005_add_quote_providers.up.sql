@@ -0,0 +1,4 @@
+-- the existing quotes are loaded by the configured default provider, the app sets the provider of the new ones
+alter table quotes add provider text not null default '';
+alter table quotes alter column provider drop default;
+alter table quotes add provider_symbol text not null default '';
@@ -0,0 +1,8 @@
+alter table quotes add exchange text not null default 'NYSE';
+alter table quotes add currency text not null default 'USD';
+alter table quotes add asset_class text not null default 'stock';
+alter table quotes add timezone text not null default 'America/New_York';
+alter table quotes add trading_hours text not null default '09:30-16:00';
+
+update quotes set exchange = 'NASDAQ'
+where symbol in ('AAPL', 'MSFT', 'TSLA', 'INTC', 'LYFT', 'ZM', 'FB', 'QCOM', 'NVDA', 'PYPL', 'CMCSA', 'CSCO', 'PDD');
@@ -0,0 +1,10 @@
+create table corporate_actions
+(
+    quote_id int not null,
+    type     text not null,
+    ex_date  timestamp not null,
+    ratio    decimal not null,
+    amount   decimal not null,
+    factor   decimal not null,
+    primary key (quote_id, type, ex_date)
+);
@@ -0,0 +1,11 @@
+create table candlestick_issues
+(
+    quote_id   int not null,
+    interval   text not null,
+    timestamp  timestamp not null,
+    type       text not null,
+    resolution text not null,
+    details    text not null,
+    created_at timestamp not null,
+    primary key (quote_id, interval, timestamp, type)
+);
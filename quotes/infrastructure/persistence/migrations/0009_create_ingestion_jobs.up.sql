@@ -0,0 +1,19 @@
+create table ingestion_jobs
+(
+    id           bigserial primary key,
+    type         text not null,
+    quote_id     int not null,
+    symbol       text not null,
+    range_from   timestamp,
+    range_to     timestamp,
+    status       text not null,
+    attempts     int not null,
+    max_attempts int not null,
+    error        text,
+    run_at       timestamp not null,
+    created_at   timestamp not null,
+    started_at   timestamp,
+    finished_at  timestamp
+);
+
+create index ingestion_jobs_status_run_at_idx on ingestion_jobs(status, run_at);
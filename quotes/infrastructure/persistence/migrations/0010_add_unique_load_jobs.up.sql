@@ -0,0 +1,2 @@
+create unique index ingestion_jobs_unfinished_load_idx on ingestion_jobs(quote_id)
+where type = 'load' and status in ('pending', 'running');
@@ -0,0 +1 @@
+alter table ingestion_jobs add heartbeat_at timestamp;
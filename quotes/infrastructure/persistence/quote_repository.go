@@ -27,6 +27,32 @@ func (r QuoteRepository) GetQuotes(status quote.Status) ([]quote.Quote, error) {
 	return quotes, nil
 }
 
+func (r QuoteRepository) FindQuotes(filter quote.Filter) ([]quote.Quote, error) {
+	var quotes []quote.Quote
+
+	query := r.db.Model(&quote.Quote{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Exchange != "" {
+		query = query.Where("exchange = ?", filter.Exchange)
+	}
+	if filter.Currency != "" {
+		query = query.Where("currency = ?", filter.Currency)
+	}
+	if filter.AssetClass != "" {
+		query = query.Where("asset_class = ?", filter.AssetClass)
+	}
+
+	err := query.Order("symbol ASC").Select(&quotes)
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "FindQuotes failed")
+	}
+
+	return quotes, nil
+}
+
 func (r QuoteRepository) GetQuote(symbol string) (*quote.Quote, error) {
 	q := &quote.Quote{}
 	err := r.db.Model(q).Where("symbol = ?", symbol).Select()
@@ -38,6 +64,17 @@ func (r QuoteRepository) GetQuote(symbol string) (*quote.Quote, error) {
 	return q, nil
 }
 
+func (r QuoteRepository) GetQuoteByID(id int64) (*quote.Quote, error) {
+	q := &quote.Quote{}
+	err := r.db.Model(q).Where("id = ?", id).Select()
+
+	if err != nil && err != pg.ErrNoRows {
+		return nil, errors.Wrap(err, "GetQuoteByID failed")
+	}
+
+	return q, nil
+}
+
 func (r QuoteRepository) UpdateQuoteStatus(quote *quote.Quote, status quote.Status) error {
 	_, err := r.db.Model(quote).
 		Set("status = ?", status).
@@ -46,3 +83,40 @@ func (r QuoteRepository) UpdateQuoteStatus(quote *quote.Quote, status quote.Stat
 
 	return errors.Wrap(err, "UpdateQuoteStatus failed")
 }
+
+func (r QuoteRepository) CreateQuote(quote *quote.Quote) error {
+	_, err := r.db.Model(quote).Returning("*").Insert()
+
+	return errors.Wrap(err, "CreateQuote failed")
+}
+
+func (r QuoteRepository) UpdateQuote(quote *quote.Quote) error {
+	_, err := r.db.Model(quote).
+		Column("symbol", "name", "provider", "provider_symbol", "exchange", "currency", "asset_class", "timezone", "trading_hours").
+		WherePK().
+		Update()
+
+	return errors.Wrap(err, "UpdateQuote failed")
+}
+
+func (r QuoteRepository) DeleteQuote(q *quote.Quote) error {
+	err := r.db.RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.Exec("DELETE FROM candlesticks WHERE quote_id = ?", q.ID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM corporate_actions WHERE quote_id = ?", q.ID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM candlestick_issues WHERE quote_id = ?", q.ID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM ingestion_jobs WHERE quote_id = ?", q.ID); err != nil {
+			return err
+		}
+
+		_, err := tx.Model(q).WherePK().Delete()
+		return err
+	})
+
+	return errors.Wrap(err, "DeleteQuote failed")
+}
@@ -0,0 +1,96 @@
+package persistence
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-pg/pg/v9"
+	"github.com/pkg/errors"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+)
+
+var errQueryCaptured = errors.New("query captured")
+
+// queryCapture keeps the queries from reaching the database, so they are tested without one
+type queryCapture struct {
+	queries []string
+}
+
+func (r *queryCapture) BeforeQuery(ctx context.Context, evt *pg.QueryEvent) (context.Context, error) {
+	q, err := evt.FormattedQuery()
+	if err != nil {
+		return ctx, err
+	}
+	r.queries = append(r.queries, q)
+
+	return ctx, errQueryCaptured
+}
+
+func (r *queryCapture) AfterQuery(context.Context, *pg.QueryEvent) error {
+	return nil
+}
+
+func newCapturingDB() (*pg.DB, *queryCapture) {
+	db := pg.Connect(&pg.Options{Addr: "127.0.0.1:1"})
+	capture := &queryCapture{}
+	db.AddQueryHook(capture)
+
+	return db, capture
+}
+
+func TestQuoteRepository_UpdateQuote_EmptyProviderSymbol(t *testing.T) {
+	db, capture := newCapturingDB()
+	defer db.Close()
+
+	err := NewQuoteRepository(db).UpdateQuote(&quote.Quote{
+		ID:           1,
+		Symbol:       "AAPL",
+		Name:         "Apple",
+		Provider:     "tiingo",
+		Exchange:     "NASDAQ",
+		Currency:     "USD",
+		AssetClass:   quote.AssetClassStock,
+		Timezone:     "America/New_York",
+		TradingHours: "09:30-16:00",
+	})
+	if errors.Cause(err) != errQueryCaptured || len(capture.queries) != 1 {
+		t.Fatal(err, capture.queries)
+	}
+
+	q := capture.queries[0]
+	if !strings.Contains(q, `"provider_symbol" = ''`) || strings.Contains(q, "NULL") {
+		t.Error(q)
+	}
+}
+
+func TestQuoteRepository_UpdateQuote_Metadata(t *testing.T) {
+	db, capture := newCapturingDB()
+	defer db.Close()
+
+	_ = NewQuoteRepository(db).UpdateQuote(&quote.Quote{
+		ID:           1,
+		Symbol:       "BTCUSD",
+		Name:         "Bitcoin",
+		Exchange:     "CRYPTO",
+		Currency:     "USD",
+		AssetClass:   quote.AssetClassCrypto,
+		Timezone:     "UTC",
+		TradingHours: quote.TradingHoursAlways,
+	})
+	if len(capture.queries) != 1 {
+		t.Fatal(capture.queries)
+	}
+
+	for _, set := range []string{
+		`"exchange" = 'CRYPTO'`,
+		`"asset_class" = 'crypto'`,
+		`"timezone" = 'UTC'`,
+		`"trading_hours" = '24/7'`,
+	} {
+		if !strings.Contains(capture.queries[0], set) {
+			t.Error(set, capture.queries[0])
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -15,54 +17,128 @@ type Client interface {
 	GetPrices(request PricesRequest) ([]Prices, error)
 }
 
-const baseURL = "https://api.tiingo.com"
+const (
+	baseURL = "https://api.tiingo.com"
+
+	defaultRequestsPerHour = 50
+	defaultMaxRetries      = 3
+	defaultBackoff         = time.Second
+	requestTimeout         = time.Minute
+)
 
 type client struct {
 	httpClient http.Client
+	baseURL    string
 	token      string
+	throttle   *throttle
+	maxRetries int
+	backoff    time.Duration
 }
 
 func NewClient(cfg *config.Tiingo) Client {
+	return newClient(baseURL, cfg, defaultBackoff)
+}
+
+func newClient(baseURL string, cfg *config.Tiingo, backoff time.Duration) *client {
+	requestsPerHour := cfg.RequestsPerHour
+	if requestsPerHour <= 0 {
+		requestsPerHour = defaultRequestsPerHour
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+
 	return &client{
-		httpClient: http.Client{},
+		httpClient: http.Client{Timeout: requestTimeout},
+		baseURL:    baseURL,
 		token:      cfg.Token,
+		throttle:   newThrottle(requestsPerHour),
+		maxRetries: maxRetries,
+		backoff:    backoff,
 	}
 }
 
+// GetPrices splits long ranges into chunks the API is able to serve in one response.
 func (r client) GetPrices(request PricesRequest) ([]Prices, error) {
-	responseBody, err := r.makeRequest(http.MethodGet, request.GetPath(), nil)
-	if err != nil {
-		return nil, err
-	}
+	var prices []Prices
+	for _, chunk := range request.Split() {
+		responseBody, err := r.makeRequest(http.MethodGet, chunk.GetPath(), nil)
+		if err != nil {
+			return nil, err
+		}
 
-	var response []Prices
-	if err := json.Unmarshal(responseBody, &response); err != nil {
-		return nil, errors.Wrap(err, "GetPrices unmarshal failed")
+		var response []Prices
+		if err := json.Unmarshal(responseBody, &response); err != nil {
+			return nil, errors.Wrap(err, "GetPrices unmarshal failed")
+		}
+
+		// chunks are stamped with dates only, so the bounds may be repeated
+		for i := range response {
+			if len(prices) > 0 && !response[i].Date.After(prices[len(prices)-1].Date) {
+				continue
+			}
+			prices = append(prices, response[i])
+		}
 	}
 
-	return response, nil
+	return prices, nil
 }
 
+// makeRequest retries temporary failures, doubling the delay every time unless the API sets Retry-After.
 func (r client) makeRequest(method, path string, requestBody io.Reader) ([]byte, error) {
-	url := baseURL + path + "&token=" + r.token
+	backoff := r.backoff
+	for attempt := 0; ; attempt++ {
+		responseBody, retryAfter, err := r.doRequest(method, path, requestBody)
+		if err == nil {
+			return responseBody, nil
+		}
+
+		statusErr, ok := err.(*StatusError)
+		if !ok || !statusErr.Temporary() || attempt >= r.maxRetries {
+			return nil, err
+		}
+
+		if retryAfter > 0 {
+			time.Sleep(retryAfter)
+		} else {
+			time.Sleep(backoff)
+		}
+		backoff *= 2
+	}
+}
+
+func (r client) doRequest(method, path string, requestBody io.Reader) ([]byte, time.Duration, error) {
+	url := r.baseURL + path + "&token=" + r.token
 	request, err := http.NewRequest(method, url, requestBody)
 	if err != nil {
-		return nil, errors.Wrap(err, "makeRequest failed")
+		return nil, 0, errors.Wrap(err, "makeRequest failed")
 	}
 
 	request.Header.Add("Accept", "application/json")
 	request.Header.Add("Content-Type", "application/json")
 
+	r.throttle.wait()
+
 	response, err := r.httpClient.Do(request)
 	if err != nil {
-		return nil, errors.Wrap(err, "makeRequest do failed")
+		return nil, 0, errors.Wrap(err, "makeRequest do failed")
 	}
 	defer response.Body.Close()
 
 	responseBody, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "makeRequest read failed")
+		return nil, 0, errors.Wrap(err, "makeRequest read failed")
+	}
+
+	if response.StatusCode != http.StatusOK {
+		retryAfter, _ := strconv.Atoi(response.Header.Get("Retry-After"))
+		return nil, time.Duration(retryAfter) * time.Second, &StatusError{
+			StatusCode: response.StatusCode,
+			Body:       string(responseBody),
+		}
 	}
 
-	return responseBody, nil
+	return responseBody, 0, nil
 }
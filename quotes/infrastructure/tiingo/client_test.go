@@ -0,0 +1,62 @@
+package tiingo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/config"
+)
+
+func TestClient_GetPrices(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `[{"date":"%sT14:30:00Z","open":1,"high":2,"low":0.5,"close":1.5,"volume":100}]`, r.URL.Query().Get("startDate"))
+	}))
+	defer server.Close()
+
+	c := newClient(server.URL, &config.Tiingo{RequestsPerHour: 3600000}, time.Millisecond)
+	prices, err := c.GetPrices(PricesRequest{
+		Ticker:       "AAPL",
+		StartDate:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		ResampleFreq: ResponseResampleFreqHour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 367 days are split into 3 chunks, the first one is retried
+	if len(prices) != 3 || requests != 4 {
+		t.Error(len(prices), requests)
+	}
+}
+
+func TestClient_GetPrices_StatusError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := newClient(server.URL, &config.Tiingo{RequestsPerHour: 3600000}, time.Millisecond)
+	_, err := c.GetPrices(PricesRequest{
+		Ticker:       "UNKNOWN",
+		StartDate:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:      time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		ResampleFreq: ResponseResampleFreqHour,
+	})
+
+	statusErr, ok := err.(*StatusError)
+	if !ok || statusErr.StatusCode != http.StatusNotFound || requests != 1 {
+		t.Error(err, requests)
+	}
+}
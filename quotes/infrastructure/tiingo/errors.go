@@ -0,0 +1,21 @@
+package tiingo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// StatusError is returned when the API responds with a non-200 status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (r *StatusError) Error() string {
+	return fmt.Sprintf("tiingo responded with status %d: %s", r.StatusCode, r.Body)
+}
+
+// Temporary tells if the request is worth retrying.
+func (r *StatusError) Temporary() bool {
+	return r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= http.StatusInternalServerError
+}
@@ -4,7 +4,26 @@ import "time"
 
 type ResponseResampleFreq string
 
-const ResponseResampleFreqHour ResponseResampleFreq = "1hour"
+const (
+	ResponseResampleFreqMinute    ResponseResampleFreq = "1min"
+	ResponseResampleFreq5Minutes  ResponseResampleFreq = "5min"
+	ResponseResampleFreq15Minutes ResponseResampleFreq = "15min"
+	ResponseResampleFreqHour      ResponseResampleFreq = "1hour"
+	ResponseResampleFreq4Hours    ResponseResampleFreq = "4hour"
+	ResponseResampleFreqDay       ResponseResampleFreq = "daily"
+	ResponseResampleFreqWeek      ResponseResampleFreq = "weekly"
+)
+
+// chunkDays keeps a response of every resample frequency within the API limits
+var chunkDays = map[ResponseResampleFreq]int{
+	ResponseResampleFreqMinute:    7,
+	ResponseResampleFreq5Minutes:  30,
+	ResponseResampleFreq15Minutes: 90,
+	ResponseResampleFreqHour:      180,
+	ResponseResampleFreq4Hours:    365,
+	ResponseResampleFreqDay:       3650,
+	ResponseResampleFreqWeek:      3650,
+}
 
 type PricesRequest struct {
 	Ticker       string
@@ -14,6 +33,10 @@ type PricesRequest struct {
 }
 
 func (r PricesRequest) GetPath() string {
+	if r.isEOD() {
+		return r.getEODPath()
+	}
+
 	path := "/iex/" + r.Ticker + "/prices"
 	path = path + "?columns=open,high,low,close,volume"
 	path = path + "&startDate=" + r.StartDate.Format("2006-01-02")
@@ -22,3 +45,37 @@ func (r PricesRequest) GetPath() string {
 
 	return path
 }
+
+// Split returns requests for consecutive date ranges covering the requested one.
+func (r PricesRequest) Split() []PricesRequest {
+	days, ok := chunkDays[r.ResampleFreq]
+	if !ok {
+		return []PricesRequest{r}
+	}
+
+	var chunks []PricesRequest
+	for start := r.StartDate; !start.After(r.EndDate); start = start.AddDate(0, 0, days) {
+		chunk := r
+		chunk.StartDate = start
+		if end := start.AddDate(0, 0, days-1); end.Before(r.EndDate) {
+			chunk.EndDate = end
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// the IEX endpoint only serves intraday bars, daily and weekly ones come from the end-of-day endpoint
+func (r PricesRequest) isEOD() bool {
+	return r.ResampleFreq == ResponseResampleFreqDay || r.ResampleFreq == ResponseResampleFreqWeek
+}
+
+func (r PricesRequest) getEODPath() string {
+	path := "/tiingo/daily/" + r.Ticker + "/prices"
+	path = path + "?startDate=" + r.StartDate.Format("2006-01-02")
+	path = path + "&endDate=" + r.EndDate.Format("2006-01-02")
+	path = path + "&resampleFreq=" + string(r.ResampleFreq)
+
+	return path
+}
@@ -11,4 +11,9 @@ type Prices struct {
 	Low    float64   `json:"low"`
 	Close  float64   `json:"close"`
 	Volume float64   `json:"volume"`
+
+	// the end-of-day endpoint only
+	AdjClose    float64 `json:"adjClose"`
+	DivCash     float64 `json:"divCash"`
+	SplitFactor float64 `json:"splitFactor"`
 }
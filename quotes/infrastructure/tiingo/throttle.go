@@ -0,0 +1,32 @@
+package tiingo
+
+import (
+	"sync"
+	"time"
+)
+
+// throttle spreads requests evenly to stay within the hourly budget
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newThrottle(requestsPerHour int) *throttle {
+	return &throttle{
+		interval: time.Hour / time.Duration(requestsPerHour),
+	}
+}
+
+func (r *throttle) wait() {
+	r.mu.Lock()
+	now := time.Now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	delay := r.next.Sub(now)
+	r.next = r.next.Add(r.interval)
+	r.mu.Unlock()
+
+	time.Sleep(delay)
+}
@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/websmee/example_of_my_code/quotes/domain/action"
+	"github.com/websmee/example_of_my_code/quotes/domain/quote"
+	"github.com/websmee/example_of_my_code/quotes/infrastructure/tiingo"
+)
+
+type tiingoActionLoader struct {
+	client tiingo.Client
+}
+
+// NewTiingoActionLoader finds splits and dividends in the end-of-day prices.
+func NewTiingoActionLoader(client tiingo.Client) action.Loader {
+	return &tiingoActionLoader{
+		client: client,
+	}
+}
+
+func (r tiingoActionLoader) LoadActions(quote quote.Quote, start, end time.Time) ([]action.Action, error) {
+	// a week earlier, so a dividend at the start has the previous close
+	prices, err := r.client.GetPrices(tiingo.PricesRequest{
+		Ticker:       quote.GetProviderSymbol(),
+		StartDate:    start.AddDate(0, 0, -7),
+		EndDate:      end,
+		ResampleFreq: tiingo.ResponseResampleFreqDay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []action.Action
+	for i := 1; i < len(prices); i++ {
+		if prices[i].Date.Before(start) {
+			continue
+		}
+		if prices[i].SplitFactor != 0 && prices[i].SplitFactor != 1 {
+			actions = append(actions, action.NewSplit(quote.ID, prices[i].Date, decimal.NewFromFloat(prices[i].SplitFactor)))
+		}
+		if prices[i].DivCash > 0 && prices[i-1].Close > 0 {
+			actions = append(actions, action.NewDividend(
+				quote.ID,
+				prices[i].Date,
+				decimal.NewFromFloat(prices[i].DivCash),
+				decimal.NewFromFloat(prices[i-1].Close),
+			))
+		}
+	}
+
+	return actions, nil
+}
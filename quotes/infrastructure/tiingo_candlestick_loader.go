@@ -22,7 +22,7 @@ func NewTiingoCandlestickLoader(client tiingo.Client) candlestick.Loader {
 
 func (r tiingoCandlestickLoader) LoadHistory(quote quote.Quote, start time.Time, end time.Time, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
 	prices, err := r.client.GetPrices(tiingo.PricesRequest{
-		Ticker:       quote.Symbol,
+		Ticker:       quote.GetProviderSymbol(),
 		StartDate:    start,
 		EndDate:      end,
 		ResampleFreq: intervalToResampleFreq(interval),
@@ -40,20 +40,50 @@ func (r tiingoCandlestickLoader) LoadHistory(quote quote.Quote, start time.Time,
 }
 
 func (r tiingoCandlestickLoader) LoadLatest(quote quote.Quote, interval candlestick.Interval) ([]candlestick.Candlestick, error) {
-	return r.LoadHistory(quote, time.Now().Add(-24*time.Hour), time.Now(), interval)
+	return r.LoadHistory(quote, time.Now().Add(-latestPeriod(interval)), time.Now(), interval)
 }
 
-func intervalToResampleFreq(_ candlestick.Interval) tiingo.ResponseResampleFreq {
-	return tiingo.ResponseResampleFreqHour
+// latestPeriod is wide enough to contain at least a couple of closed candlesticks of the interval
+func latestPeriod(interval candlestick.Interval) time.Duration {
+	if period := 2 * interval.Duration(); period > 24*time.Hour {
+		return period
+	}
+
+	return 24 * time.Hour
+}
+
+func intervalToResampleFreq(interval candlestick.Interval) tiingo.ResponseResampleFreq {
+	switch interval {
+	case candlestick.IntervalMinute:
+		return tiingo.ResponseResampleFreqMinute
+	case candlestick.Interval5Minutes:
+		return tiingo.ResponseResampleFreq5Minutes
+	case candlestick.Interval15Minutes:
+		return tiingo.ResponseResampleFreq15Minutes
+	case candlestick.Interval4Hours:
+		return tiingo.ResponseResampleFreq4Hours
+	case candlestick.IntervalDay:
+		return tiingo.ResponseResampleFreqDay
+	case candlestick.IntervalWeek:
+		return tiingo.ResponseResampleFreqWeek
+	default:
+		return tiingo.ResponseResampleFreqHour
+	}
 }
 
 func pricesToCandlestick(prices tiingo.Prices, quoteID int64, interval candlestick.Interval) candlestick.Candlestick {
+	// intraday prices aren't adjusted by the API, the adjusted ones are computed from the corporate actions on reading anyway
+	adjClose := prices.AdjClose
+	if adjClose == 0 {
+		adjClose = prices.Close
+	}
+
 	return candlestick.Candlestick{
 		Open:      decimal.NewFromFloat(prices.Open),
 		Low:       decimal.NewFromFloat(prices.Low),
 		High:      decimal.NewFromFloat(prices.High),
 		Close:     decimal.NewFromFloat(prices.Close),
-		AdjClose:  decimal.NewFromInt(0),
+		AdjClose:  decimal.NewFromFloat(adjClose),
 		Volume:    int(prices.Volume),
 		Timestamp: prices.Date,
 		Interval:  interval,